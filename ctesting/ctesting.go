@@ -2,6 +2,7 @@ package ctesting
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -15,21 +16,31 @@ import (
 // Type parameter t must implement TestOutputType (be comparable).
 //
 // Fields:
+//   - Name: Optional case name included in the reported results
 //   - err: The actual error returned by the test function (populated during test execution)
 //   - ExpectedErr: The expected error that should be returned
 //   - output: The actual output returned by the test function (populated during test execution)
 //   - ExpectedOutput: The expected output value
 //   - F: The test function to execute
+//   - RepeatCount: When greater than 1, F is called this many times and the test fails
+//     unless every call returns the same output and error as the first one, catching
+//     functions that depend on package-level state or call order
 //
 // Example usage, this test expects sum(1,2) to return 3 with no error:
 //
 //	test := NewCharacterizationTest(3, nil, func() (int, error) { return sum(1, 2), nil })
 type CharacterizationTest[t comparable] struct {
+	Name           string
 	err            error
 	ExpectedErr    error
 	output         t
 	ExpectedOutput t
 	F              gtu.TestFunc[t]
+	RepeatCount    int
+
+	unstableCall   int
+	unstableOutput t
+	unstableErr    error
 }
 
 // NewCharacterizationTest creates a new CharacterizationTest instance with the specified
@@ -60,6 +71,108 @@ func NewCharacterizationTest[t comparable](expectedOutput t, expectedError error
 	}
 }
 
+// NewNamedCharacterizationTest creates a new CharacterizationTest like NewCharacterizationTest,
+// additionally setting its Name so that reported results identify the test.
+//
+// Parameters:
+//   - name: The name reported alongside the test number by VerifyResults
+//   - expectedOutput: The value that the test function is expected to return
+//   - expectedError: The error that the test function is expected to return (use nil if no error expected)
+//   - function: The test function to execute, must match TestFunc[t] signature
+//
+// Example usage:
+//
+//	test := NewNamedCharacterizationTest("sum of small ints", 3, nil, func() (int, error) { return sum(1, 2), nil })
+func NewNamedCharacterizationTest[t comparable](name string, expectedOutput t, expectedError error, function gtu.TestFunc[t]) CharacterizationTest[t] {
+	return NewCharacterizationTest(expectedOutput, expectedError, function).WithName(name)
+}
+
+// WithName returns a copy of the test with its Name set, so that reported results
+// identify the test.
+//
+// Example usage:
+//
+//	test := NewCharacterizationTest(3, nil, func() (int, error) { return sum(1, 2), nil }).WithName("sum")
+func (ct CharacterizationTest[t]) WithName(name string) CharacterizationTest[t] {
+	ct.Name = name
+	return ct
+}
+
+// WithRepeatCount returns a copy of the test with its RepeatCount set, so that F is
+// called n times and must return the same output and error on every call.
+//
+// Example usage:
+//
+//	test := NewCharacterizationTest(3, nil, func() (int, error) { return next(), nil }).WithRepeatCount(5)
+func (ct CharacterizationTest[t]) WithRepeatCount(n int) CharacterizationTest[t] {
+	ct.RepeatCount = n
+	return ct
+}
+
+// run calls F, repeating the call RepeatCount times when it is greater than 1, and
+// records the output and error of the first call along with the first call whose
+// results differ from it.
+func (ct *CharacterizationTest[t]) run() {
+	ct.output, ct.err = ct.F()
+	ct.unstableCall = 0
+	for call := 2; call <= ct.RepeatCount; call++ {
+		output, err := ct.F()
+		if !reflect.DeepEqual(output, ct.output) || errorMessage(err) != errorMessage(ct.err) {
+			ct.unstableCall, ct.unstableOutput, ct.unstableErr = call, output, err
+			return
+		}
+	}
+}
+
+// errorMessage returns the message of err, or "" when err is nil.
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// TableCase describes one row of a table-driven characterization suite built with
+// NewSuiteFromTable.
+//
+// Fields:
+//   - Name: The case name reported by VerifyResults
+//   - Input: The input passed to the function under test
+//   - Want: The expected output
+//   - WantErr: The expected error (nil if no error is expected)
+type TableCase[T comparable, In any] struct {
+	Name    string
+	Input   In
+	Want    T
+	WantErr error
+}
+
+// NewSuiteFromTable builds a characterization suite from a table of cases, calling fn
+// with each case's input. Case names are preserved so VerifyResults can report which
+// case failed.
+//
+// Parameters:
+//   - cases: The table rows, each with a name, an input and the expected results
+//   - fn: The function under test, called once per case with the case input
+//
+// Returns a suite with one CharacterizationTest per case, in table order.
+//
+// Example usage:
+//
+//	suite := NewSuiteFromTable([]TableCase[int, string]{
+//	    {Name: "empty", Input: "", Want: 0},
+//	    {Name: "word", Input: "go", Want: 2},
+//	}, func(s string) (int, error) { return len(s), nil })
+//	VerifyCharacterizationTestsAndResults(t, suite, true)
+func NewSuiteFromTable[T comparable, In any](cases []TableCase[T, In], fn func(In) (T, error)) []CharacterizationTest[T] {
+	suite := make([]CharacterizationTest[T], 0, len(cases))
+	for _, c := range cases {
+		input := c.Input
+		suite = append(suite, NewNamedCharacterizationTest(c.Name, c.Want, c.WantErr, func() (T, error) { return fn(input) }))
+	}
+	return suite
+}
+
 // VerifyCharacterizationTests executes a suite of characterization tests and returns
 // the results of each test along with the updated test suite containing actual outputs.
 //
@@ -80,6 +193,9 @@ func NewCharacterizationTest[t comparable](expectedOutput t, expectedError error
 //   - Both expected and actual errors are non-nil and have the same error message, OR
 //   - The expected output exactly matches the actual output (using reflect.DeepEqual)
 //
+// and, when RepeatCount is greater than 1, every repeated call returned the same output
+// (using reflect.DeepEqual) and error message as the first call.
+//
 // Example usage from tests, results[0] will be true if sum(1,2) returns 3 with no error:
 //
 //	testSuite := []CharacterizationTest[int]{
@@ -88,14 +204,15 @@ func NewCharacterizationTest[t comparable](expectedOutput t, expectedError error
 //	results, testSuiteRes := VerifyCharacterizationTests(testSuite)
 func VerifyCharacterizationTests[t comparable](
 	testSuite []CharacterizationTest[t], isDeepErrorCheck bool) (res []bool, _ []CharacterizationTest[t]) {
-	for i, test := range testSuite {
-		output, err := test.F()
-		testSuite[i].output = output
-		testSuite[i].err = err
-		if isDeepErrorCheck {
-			res = append(res, deepErrorCheck(err, test, output))
+	for i := range testSuite {
+		testSuite[i].run()
+		test := testSuite[i]
+		if test.unstableCall != 0 {
+			res = append(res, false)
+		} else if isDeepErrorCheck {
+			res = append(res, deepErrorCheck(test.err, test, test.output))
 		} else {
-			res = append(res, shallowErrorCheck(err, test, output))
+			res = append(res, shallowErrorCheck(test.err, test, test.output))
 		}
 	}
 	return res, testSuite
@@ -134,7 +251,8 @@ func shallowErrorCheck[t comparable](err error, test CharacterizationTest[t], ou
 //   - testSuiteRes: Updated test suite from VerifyCharacterizationTests with actual outputs
 //
 // Behavior:
-//   - For failed tests (results[i] == false): Calls t.Errorf with detailed comparison
+//   - For failed tests (results[i] == false): Calls t.Errorf with detailed comparison, or
+//     with the results of the first and the diverging call when a repeated call differed
 //   - For successful tests (results[i] == true): Calls t.Logf with success information
 //
 // Example usage from tests:
@@ -148,16 +266,27 @@ func shallowErrorCheck[t comparable](err error, test CharacterizationTest[t], ou
 //	VerifyResults(&mockT, results, testSuiteRes)
 func VerifyResults[T comparable](t *testing.T, results []bool, testSuiteRes []CharacterizationTest[T]) {
 	for i, result := range results {
-		if !result {
-			t.Errorf("test number %d: ERROR [ERRORS] got error {%v}, expected {%v}, [VALUES] got {%v} expected {%v}",
-				i+1, testSuiteRes[i].err, testSuiteRes[i].ExpectedErr, testSuiteRes[i].output, testSuiteRes[i].ExpectedOutput)
+		if test := testSuiteRes[i]; test.unstableCall != 0 {
+			t.Errorf("%s: ERROR [UNSTABLE] call %d got error {%v} value {%v}, first call got error {%v} value {%v}",
+				testLabel(i, test.Name), test.unstableCall, test.unstableErr, test.unstableOutput, test.err, test.output)
+		} else if !result {
+			t.Errorf("%s: ERROR [ERRORS] got error {%v}, expected {%v}, [VALUES] got {%v} expected {%v}",
+				testLabel(i, testSuiteRes[i].Name), testSuiteRes[i].err, testSuiteRes[i].ExpectedErr, testSuiteRes[i].output, testSuiteRes[i].ExpectedOutput)
 		} else {
-			t.Logf("test number %d: SUCCESS [ERRORS] got error {%v}, expected {%v}, [VALUES] got {%v} expected {%v}",
-				i+1, testSuiteRes[i].err, testSuiteRes[i].ExpectedErr, testSuiteRes[i].output, testSuiteRes[i].ExpectedOutput)
+			t.Logf("%s: SUCCESS [ERRORS] got error {%v}, expected {%v}, [VALUES] got {%v} expected {%v}",
+				testLabel(i, testSuiteRes[i].Name), testSuiteRes[i].err, testSuiteRes[i].ExpectedErr, testSuiteRes[i].output, testSuiteRes[i].ExpectedOutput)
 		}
 	}
 }
 
+// testLabel identifies a test in reported results by its position and, when set, its name.
+func testLabel(i int, name string) string {
+	if name == "" {
+		return fmt.Sprintf("test number %d", i+1)
+	}
+	return fmt.Sprintf("test number %d (%s)", i+1, name)
+}
+
 // VerifyCharacterizationTestsAndResults is a convenience function that combines
 // VerifyCharacterizationTests and VerifyResults into a single call. This function
 // executes the test suite and immediately reports the results using the provided
@@ -73,3 +73,65 @@ func TestVerifyCharacterizationTestsErrors(t *testing.T) {
 		t.Error("The results are incorrect")
 	}
 }
+
+// Tests that a table of cases is turned into a suite that keeps case names
+func TestNewSuiteFromTable(t *testing.T) {
+	suite := NewSuiteFromTable([]TableCase[int, [2]int]{
+		{Name: "small", Input: [2]int{1, 2}, Want: 3},
+		{Name: "negative", Input: [2]int{-1, -2}, Want: -3},
+		{Name: "wrong", Input: [2]int{1, 1}, Want: 3},
+	}, func(in [2]int) (int, error) { return sum(in[0], in[1]), nil })
+	if len(suite) != 3 || suite[0].Name != "small" || suite[2].Name != "wrong" {
+		t.Fatalf("expected names to be preserved, got %+v", suite)
+	}
+	results, _ := VerifyCharacterizationTests(suite, true)
+	if !results[0] || !results[1] || results[2] {
+		t.Errorf("The results are incorrect: %v", results)
+	}
+}
+
+func TestTestLabel(t *testing.T) {
+	if got := testLabel(0, ""); got != "test number 1" {
+		t.Errorf("unexpected label %q", got)
+	}
+	if got := testLabel(2, "empty input"); got != "test number 3 (empty input)" {
+		t.Errorf("unexpected label %q", got)
+	}
+}
+
+// Tests that names set on tests survive execution and are available for reporting
+func TestNamedCharacterizationTests(t *testing.T) {
+	testSuite := []CharacterizationTest[int]{
+		NewNamedCharacterizationTest("sum", 3, nil, func() (int, error) { return sum(1, 2), nil }),
+		NewCharacterizationTest(1, fmt.Errorf("%s", testErrorMessage), func() (int, error) { return getError() }).WithName("error"),
+	}
+	results, testSuiteRes := VerifyCharacterizationTestsAndResults(t, testSuite, true)
+	if !results[0] || !results[1] {
+		t.Errorf("The results are incorrect: %v", results)
+	}
+	if testSuiteRes[0].Name != "sum" || testSuiteRes[1].Name != "error" {
+		t.Errorf("expected names to be preserved, got %q and %q", testSuiteRes[0].Name, testSuiteRes[1].Name)
+	}
+}
+
+// Tests that repeated calls must agree with the first one
+func TestRepeatCount(t *testing.T) {
+	calls := 0
+	counter := func() (int, error) { calls++; return calls, nil }
+	testSuite := []CharacterizationTest[int]{
+		NewCharacterizationTest(3, nil, func() (int, error) { return sum(1, 2), nil }).WithRepeatCount(5),
+		NewCharacterizationTest(1, fmt.Errorf("%s", testErrorMessage), func() (int, error) { return getError() }).WithRepeatCount(3),
+		NewCharacterizationTest(1, nil, counter).WithRepeatCount(3),
+		NewCharacterizationTest(3, nil, counter),
+	}
+	results, testSuiteRes := VerifyCharacterizationTests(testSuite, true)
+	if !results[0] || !results[1] || results[2] || !results[3] {
+		t.Errorf("The results are incorrect: %v", results)
+	}
+	if testSuiteRes[2].unstableCall != 2 || testSuiteRes[2].unstableOutput != 2 || testSuiteRes[3].unstableCall != 0 {
+		t.Errorf("expected only the second call of the counter to be reported as unstable, got call %d with %d",
+			testSuiteRes[2].unstableCall, testSuiteRes[2].unstableOutput)
+	}
+	mockT := testing.T{}
+	VerifyResults(&mockT, results, testSuiteRes)
+}
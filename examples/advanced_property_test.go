@@ -1,6 +1,7 @@
 package examples
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
@@ -241,19 +242,23 @@ func TestCustomStructProperty(t *testing.T) {
 
 	truePred := TruePredicate{}
 
+	// Generate real Point values rather than the default two-field struct
+	attrs := attributes.NewFTAttributes()
+	attrs.StructAttr = attributes.StructAttributes{TargetType: reflect.TypeOf(Point{}), FillUnlisted: true}
+
 	test := pbtesting.NewPBTest(translate).
 		WithIterations(50).
 		WithPredicates(truePred).
 		WithT(t)
 
-	results, err := test.Run()
+	results, err := test.RunWithAttributes(attrs)
 	if err != nil {
 		t.Fatalf("Property test failed: %v", err)
 	}
 
 	successCount := 0
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			successCount++
 			translated := result.Output.(Point)
 			_ = translated
@@ -363,7 +368,7 @@ func TestErrorHandlingProperty(t *testing.T) {
 
 	successCount := 0
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			successCount++
 		}
 	}
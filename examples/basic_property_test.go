@@ -173,7 +173,7 @@ func TestIdempotenceProperty(t *testing.T) {
 
 	// Additional check: verify idempotence manually
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			val := result.Output.(int)
 			// Apply function again
 			val2 := absFunc(val)
@@ -330,7 +330,7 @@ func TestAssociativityProperty(t *testing.T) {
 
 	successCount := 0
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			successCount++
 		}
 	}
@@ -1,6 +1,7 @@
 package examples
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/laiambryant/gotestutils/stesting"
@@ -17,7 +18,7 @@ func TestMemoryAllocationStress(t *testing.T) {
 	}
 	var dummyVar int
 	stressTest := stesting.NewStressTest(100, memoryIntensiveFunc, &dummyVar)
-	success, err := stesting.RunStressTestWithFilePathOut(&stressTest, "memory_test.log")
+	success, err := stesting.RunStressTestWithFilePathOut(&stressTest, filepath.Join(t.TempDir(), "memory_test.log"))
 	if !success {
 		t.Errorf("Memory stress test failed: %v", err)
 	}
@@ -62,7 +62,7 @@ func TestIdempotencePattern(t *testing.T) {
 
 	// Verify idempotence: sort(sort(x)) should equal sort(x)
 	for i, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			once := result.Output.([]int)
 			twice := sortFunc(once)
 
@@ -110,7 +110,7 @@ func TestCommutativityPattern(t *testing.T) {
 
 	successCount := 0
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			successCount++
 		}
 	}
@@ -147,7 +147,7 @@ func TestAssociativityPattern(t *testing.T) {
 
 	successCount := 0
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			successCount++
 		}
 	}
@@ -179,7 +179,7 @@ func TestIdentityPattern(t *testing.T) {
 	// All outputs should equal their inputs (but we can't access inputs directly)
 	successCount := 0
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			successCount++
 		}
 	}
@@ -200,8 +200,6 @@ func TestInvariantPattern(t *testing.T) {
 		return result
 	}
 
-	lengthCheck := SliceLengthPredicate{MinLength: 0, MaxLength: 100}
-
 	ftAttrs := attributes.NewFTAttributes()
 	ftAttrs.SliceAttr = attributes.SliceAttributes{
 		MinLen:       5,
@@ -209,20 +207,13 @@ func TestInvariantPattern(t *testing.T) {
 		ElementAttrs: attributes.IntegerAttributesImpl[int]{},
 	}
 
-	test := pbtesting.NewPBTest(sortSlice).
-		WithIterations(100).
-		WithPredicates(lengthCheck).
-		WithArgAttributes(ftAttrs).
-		WithT(t)
-
-	results, err := test.Run()
+	violations, err := pbtesting.CheckLengthRelation(sortSlice, nil, 100, ftAttrs)
 	if err != nil {
 		t.Fatalf("Invariant property test failed: %v", err)
 	}
 
-	failures := pbtesting.FilterPBTTestOut(results)
-	if len(failures) > 0 {
-		t.Errorf("Length invariant violated in %d cases", len(failures))
+	for _, v := range violations {
+		t.Errorf("Length invariant violated: sort(%v) = %v, expected length %d", v.Input, v.Output, v.Expected)
 	}
 }
 
@@ -290,7 +281,7 @@ func TestRoundTripPattern(t *testing.T) {
 
 	// Verify round-trip for lowercase inputs
 	for i, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			encoded := result.Output.(string)
 			decoded := decode(encoded)
 			// In a real test, we'd verify decoded equals original
@@ -322,8 +313,6 @@ func TestComparisonPattern(t *testing.T) {
 		return n * (n + 1) / 2
 	}
 
-	nonNegative := NonNegativePredicate{}
-
 	ftAttrs := attributes.NewFTAttributes()
 	ftAttrs.IntegerAttr = attributes.IntegerAttributesImpl[int]{
 		Min:           0,
@@ -331,26 +320,16 @@ func TestComparisonPattern(t *testing.T) {
 		AllowNegative: false,
 	}
 
-	test := pbtesting.NewPBTest(sumIterative).
+	// Apply both implementations to the same inputs and compare their outputs
+	mismatches, err := pbtesting.NewOracleTest(sumFormula, sumIterative).
 		WithIterations(50).
-		WithPredicates(nonNegative).
-		WithArgAttributes(ftAttrs).
-		WithT(t)
-
-	results, err := test.Run()
+		RunWithAttributes(ftAttrs)
 	if err != nil {
 		t.Fatalf("Comparison property test failed: %v", err)
 	}
 
-	// Compare both implementations (we'd need to store inputs for real test)
-	for i, result := range results {
-		if result.Ok {
-			resultIterative := result.Output.(int)
-			// In real test, compute resultFormula with same input
-			_ = resultIterative
-			_ = sumFormula
-			t.Logf("Iteration %d: result=%d", i, resultIterative)
-		}
+	for _, m := range mismatches {
+		t.Errorf("Input %v: formula=%v, iterative=%v", m.Inputs, m.Candidate, m.Reference)
 	}
 }
 
@@ -389,7 +368,7 @@ func TestBoundaryPattern(t *testing.T) {
 	// Count how many succeeded (including zero denominator cases)
 	successCount := 0
 	for _, result := range results {
-		if result.Ok {
+		if result.Passed() {
 			successCount++
 		}
 	}
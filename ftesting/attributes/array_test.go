@@ -118,3 +118,38 @@ func TestArrayAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestArrayAttributes_ReflectTypeElements(t *testing.T) {
+	attrs := ArrayAttributes{Length: 5, ElementAttrs: reflect.TypeOf(0)}
+	nonZero := 0
+	for i := 0; i < 20; i++ {
+		arr, ok := attrs.GetRandomValue().([5]int)
+		if !ok {
+			t.Fatalf("expected [5]int, got %T", attrs.GetRandomValue())
+		}
+		for _, v := range arr {
+			if v != 0 {
+				nonZero++
+			}
+		}
+	}
+	if nonZero == 0 {
+		t.Error("expected random non-zero elements for a reflect.Type element spec")
+	}
+}
+
+func TestArrayAttributes_ReflectTypeElementsConverted(t *testing.T) {
+	attrs := ArrayAttributes{Length: 3, ElementAttrs: reflect.TypeOf(int8(0))}
+	if _, ok := attrs.GetRandomValue().([3]int8); !ok {
+		t.Fatalf("expected [3]int8, got %T", attrs.GetRandomValue())
+	}
+	strs := ArrayAttributes{Length: 3, ElementAttrs: reflect.TypeOf("")}
+	arr, ok := strs.GetRandomValue().([3]string)
+	if !ok || arr[0] == "" {
+		t.Errorf("expected generated strings, got %#v", strs.GetRandomValue())
+	}
+	chans := ArrayAttributes{Length: 2, ElementAttrs: reflect.TypeOf(make(chan int))}
+	if _, ok := chans.GetRandomValue().([2]chan int); !ok {
+		t.Errorf("expected zero-valued elements for kinds without defaults, got %T", chans.GetRandomValue())
+	}
+}
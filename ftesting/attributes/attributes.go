@@ -107,10 +107,12 @@ package attributes
 
 import (
 	"fmt"
-	"math/rand"
+	"math"
 	"reflect"
+	"sort"
 
 	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
+	"github.com/laiambryant/gotestutils/utils"
 )
 
 // FTAttributes is the central configuration struct for fuzz testing input generation.
@@ -126,12 +128,34 @@ import (
 //   - FloatAttr: Configuration for floating-point types (float32, float64)
 //   - ComplexAttr: Configuration for complex number types (complex64, complex128)
 //   - StringAttr: Configuration for string generation
+//   - WordListAttr: If its Words list is not empty, used instead of StringAttr to generate
+//     strings made of words
 //   - SliceAttr: Configuration for slice generation
 //   - BoolAttr: Configuration for boolean generation
 //   - MapAttr: Configuration for map generation
 //   - PointerAttr: Configuration for pointer generation (including multi-level pointers)
 //   - StructAttr: Configuration for struct generation
 //   - ArrayAttr: Configuration for array generation
+//   - JSONAttr: Configuration for JSON-like values of parameters of type any
+//   - InterfaceAttr: Nil and typed nil values mixed into parameters of other interface types
+//   - FuncAttr: Configuration for function generation, such as recording the calls made
+//     to generated callbacks
+//   - ContextAttr: Contexts passed to context.Context parameters, context.Background() by
+//     default, optionally cancelled or expiring to exercise cancellation handling
+//   - TypeAttrs: Attributes for specific types, taking precedence over the attributes of
+//     their kind (see RegisterType); NewFTAttributes seeds it from RegisterGlobalType
+//   - MaxGeneratedElements: If positive, caps the number of leaf values (numbers, strings,
+//     bools, ...) generated for a single composite value; slices and maps are truncated and
+//     the remaining array elements and struct fields are left at their zero value once the
+//     cap is reached. Leaf types are not affected. GenerateValueReport reports truncated
+//     values, FTesting counts them and PBTest logs a warning when any was truncated.
+//   - Generation: Settings shared by all generators, such as the retry budget of
+//     constrained generators
+//   - Deterministic: Makes the generated values depend only on the random source: struct
+//     fields are laid out and generated in name order instead of map iteration order.
+//     Combined with Seed (see WithDeterministic) every run then generates identical values;
+//     fmt and encoding/json already print maps with sorted keys, and KeyOrders gives a
+//     stable iteration order for maps generated with RecordKeyOrder
 //
 // Example usage:
 //
@@ -139,18 +163,45 @@ import (
 //	attrs.IntegerAttr = IntegerAttributesImpl[int]{Min: 0, Max: 100, AllowZero: false}
 //	attrs.StringAttr = StringAttributes{MinLen: 5, MaxLen: 20}
 type FTAttributes struct {
-	IntegerAttr  IntegerAttributes
-	UIntegerAttr UnsignedIntegerAttributes
-	FloatAttr    FloatAttributes
-	ComplexAttr  ComplexAttributes
-	StringAttr   StringAttributes
-	SliceAttr    SliceAttributes
-	BoolAttr     BoolAttributes
-	MapAttr      MapAttributes
-	PointerAttr  PointerAttributes
-	StructAttr   StructAttributes
-	ArrayAttr    ArrayAttributes
-}
+	IntegerAttr   IntegerAttributes
+	UIntegerAttr  UnsignedIntegerAttributes
+	FloatAttr     FloatAttributes
+	ComplexAttr   ComplexAttributes
+	StringAttr    StringAttributes
+	WordListAttr  WordListAttributes
+	SliceAttr     SliceAttributes
+	BoolAttr      BoolAttributes
+	MapAttr       MapAttributes
+	PointerAttr   PointerAttributes
+	StructAttr    StructAttributes
+	ArrayAttr     ArrayAttributes
+	JSONAttr      JSONAttributes
+	InterfaceAttr InterfaceAttributes
+	FuncAttr      FuncAttributes
+	ContextAttr   ContextAttributes
+	TypeAttrs     map[reflect.Type]Attributes
+
+	MaxGeneratedElements int
+	Generation           GenerationConfig
+	Deterministic        bool
+}
+
+// Default maximum sizes of generated strings, slices and maps. They are used when an
+// attribute leaves its maximum unset, and by NewFTAttributes and the
+// GetDefaultImplementation methods, so raising them scales up the breadth of every
+// collection at once. Set them before generating; they are not safe to change while
+// values are being generated concurrently.
+//
+// Example usage:
+//
+//	attributes.DefaultMaxSliceLen = 50
+//	attributes.DefaultMaxMapSize = 50
+//	attrs := attributes.NewFTAttributes()
+var (
+	DefaultMaxStringLen = 10
+	DefaultMaxSliceLen  = 5
+	DefaultMaxMapSize   = 5
+)
 
 // NewFTAttributes creates and returns an FTAttributes instance with sensible default
 // configurations for all supported types. These defaults are designed to work well
@@ -161,36 +212,188 @@ type FTAttributes struct {
 //   - Unsigned integers: Range [0, 100], allow zero
 //   - Floats: Range [-100.0, 100.0], finite only, non-zero
 //   - Complex: Real and imaginary parts in range [-10.0, 10.0]
-//   - Strings: Length [1, 10] characters
-//   - Slices: Length [1, 5] elements, with integer elements
+//   - Strings: Length [1, DefaultMaxStringLen] characters (10 unless changed)
+//   - Slices: Length [1, DefaultMaxSliceLen] elements (5 unless changed), with integer elements
 //   - Bools: Random true/false
-//   - Maps: Size [1, 5] entries, string keys and integer values
+//   - Maps: Size [1, DefaultMaxMapSize] entries (5 unless changed), string keys and integer values
 //   - Pointers: Allow nil, depth 1, integer inner type
 //   - Structs: Two fields (Field1: int, Field2: float32)
 //   - Arrays: Length 5, integer elements
+//   - any: JSON-like values nested at most 3 levels deep with at most 5 elements per level
+//   - Types registered with RegisterGlobalType: their registered attributes
+//
+// Options are applied in order on top of these defaults.
+//
+// Parameters:
+//   - opts: Optional FTOption values such as WithIntegerRange, WithStringLength or WithSeed
 //
 // Returns an FTAttributes instance ready for use with FTesting.
 //
 // Example usage:
 //
-//	attrs := NewFTAttributes()
-//	// Optionally override specific attributes:
-//	attrs.IntegerAttr = IntegerAttributesImpl[int]{Min: 1, Max: 1000}
+//	attrs := NewFTAttributes(WithIntegerRange(1, 1000), WithStringLength(3, 8))
+//	// Fields can still be overridden directly:
+//	attrs.FloatAttr = FloatAttributesImpl[float64]{Min: 0, Max: 1}
 //	ft.WithAttributes(attrs)
-func NewFTAttributes() FTAttributes {
-	return FTAttributes{
+func NewFTAttributes(opts ...FTOption) FTAttributes {
+	attrs := FTAttributes{
 		IntegerAttr:  IntegerAttributesImpl[int]{AllowNegative: true, AllowZero: true, Max: 100, Min: -100},
 		UIntegerAttr: UnsignedIntegerAttributesImpl[uint]{Signed: true, AllowNegative: true, AllowZero: true, Max: 100, Min: 0},
 		FloatAttr:    FloatAttributesImpl[float64]{Min: -100.0, Max: 100.0, NonZero: true, FiniteOnly: true},
 		ComplexAttr:  ComplexAttributesImpl[complex128]{RealMin: -10.0, RealMax: 10.0, ImagMin: -10.0, ImagMax: 10.0},
-		StringAttr:   StringAttributes{MinLen: 1, MaxLen: 10},
-		SliceAttr:    SliceAttributes{MinLen: 1, MaxLen: 5, ElementAttrs: IntegerAttributesImpl[int]{}},
+		StringAttr:   StringAttributes{MinLen: 1, MaxLen: DefaultMaxStringLen},
+		SliceAttr:    SliceAttributes{MinLen: 1, MaxLen: DefaultMaxSliceLen, ElementAttrs: IntegerAttributesImpl[int]{}},
 		BoolAttr:     BoolAttributes{ForceTrue: false},
-		MapAttr:      MapAttributes{MinSize: 1, MaxSize: 5, KeyAttrs: StringAttributes{MinLen: 1, MaxLen: 5}, ValueAttrs: IntegerAttributesImpl[int]{}},
+		MapAttr:      MapAttributes{MinSize: 1, MaxSize: DefaultMaxMapSize, KeyAttrs: StringAttributes{MinLen: 1, MaxLen: 5}, ValueAttrs: IntegerAttributesImpl[int]{}},
 		PointerAttr:  PointerAttributes{AllowNil: true, Depth: 1, Inner: IntegerAttributesImpl[int]{}},
 		StructAttr:   StructAttributes{FieldAttrs: map[string]any{"Field1": IntegerAttributesImpl[int]{}, "Field2": FloatAttributesImpl[float32]{Min: -10.0, Max: 10.0}}},
 		ArrayAttr:    ArrayAttributes{Length: 5, ElementAttrs: IntegerAttributesImpl[int]{}},
+		JSONAttr:     JSONAttributes{MaxDepth: 3, MaxBreadth: 5},
+		TypeAttrs:    globalTypeAttrs(),
+	}
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+	return attrs
+}
+
+// FTOption customizes the FTAttributes built by NewFTAttributes.
+type FTOption func(*FTAttributes)
+
+// WithIntegerRange makes signed integers be generated in [min, max].
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes(WithIntegerRange(0, 10))
+func WithIntegerRange(min, max int) FTOption {
+	return func(mt *FTAttributes) {
+		mt.IntegerAttr = IntegerAttributesImpl[int]{Min: min, Max: max, AllowNegative: min < 0, AllowZero: min <= 0 && max >= 0}
+	}
+}
+
+// WithStringLength makes strings be generated with between min and max characters,
+// keeping the rest of the string configuration.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes(WithStringLength(5, 5))
+func WithStringLength(min, max int) FTOption {
+	return func(mt *FTAttributes) {
+		mt.StringAttr.MinLen, mt.StringAttr.MaxLen = min, max
+	}
+}
+
+// WithSeed reseeds the random source shared by all generators when the attributes are
+// built, so that the inputs generated afterwards are reproducible. See Seed for the
+// caveats of the shared source.
+//
+// Note: the seed is not bound to the returned attributes. Calling NewFTAttributes with
+// WithSeed reseeds the package-wide source as a side effect, at that moment, which also
+// changes the values generated by every other FTAttributes in the process, including
+// those of tests running in parallel.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes(WithSeed(42))
+func WithSeed(seed int64) FTOption {
+	return func(*FTAttributes) { Seed(seed) }
+}
+
+// WithDeterministic enables Deterministic and reseeds the shared random source, so that
+// every run built with the same seed generates identical values, struct values included.
+//
+// Note: only the Deterministic flag belongs to the returned attributes; the reseeding
+// works like WithSeed. NewFTAttributes with WithDeterministic reseeds the global source
+// that every FTAttributes draws from, once, while the attributes are built. Building
+// another FTAttributes, or generating values in a parallel test, in between shifts the
+// sequence, so runs are only identical when nothing else draws from the source.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes(WithDeterministic(42))
+func WithDeterministic(seed int64) FTOption {
+	return func(mt *FTAttributes) {
+		mt.Deterministic = true
+		Seed(seed)
+	}
+}
+
+// kindFields maps each supported reflect.Kind to the FTAttributes field configuring it.
+var kindFields = map[reflect.Kind]string{
+	reflect.Int: "IntegerAttr", reflect.Int8: "IntegerAttr", reflect.Int16: "IntegerAttr", reflect.Int32: "IntegerAttr", reflect.Int64: "IntegerAttr",
+	reflect.Uint: "UIntegerAttr", reflect.Uint8: "UIntegerAttr", reflect.Uint16: "UIntegerAttr", reflect.Uint32: "UIntegerAttr", reflect.Uint64: "UIntegerAttr",
+	reflect.Float32: "FloatAttr", reflect.Float64: "FloatAttr",
+	reflect.Complex64: "ComplexAttr", reflect.Complex128: "ComplexAttr",
+	reflect.String: "StringAttr", reflect.Slice: "SliceAttr", reflect.Bool: "BoolAttr",
+	reflect.Map: "MapAttr", reflect.Pointer: "PointerAttr", reflect.Struct: "StructAttr", reflect.Array: "ArrayAttr",
+	reflect.Interface: "JSONAttr", reflect.Func: "FuncAttr",
+}
+
+// kindAttributes returns the field of mt that kindFields lists for kind, or nil when no
+// field configures it.
+func (mt FTAttributes) kindAttributes(kind reflect.Kind) Attributes {
+	name, ok := kindFields[kind]
+	if !ok {
+		return nil
+	}
+	attrs, _ := reflect.ValueOf(mt).FieldByName(name).Interface().(Attributes)
+	return attrs
+}
+
+// Merge returns a copy of mt in which every non-zero field of override replaces the
+// corresponding field of mt. Fields left at their zero value in override keep the value
+// from mt, which makes it easy to layer a partial configuration onto a base one.
+//
+// Parameters:
+//   - override: The attributes to layer on top of mt
+//
+// Returns the merged FTAttributes; neither mt nor override is modified.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes().Merge(FTAttributes{
+//	    StringAttr: StringAttributes{MinLen: 5, MaxLen: 8},
+//	})
+//	// attrs uses the default configuration for every type except strings
+func (mt FTAttributes) Merge(override FTAttributes) FTAttributes {
+	merged := reflect.ValueOf(&mt).Elem()
+	over := reflect.ValueOf(override)
+	for i := 0; i < over.NumField(); i++ {
+		if !over.Field(i).IsZero() {
+			merged.Field(i).Set(over.Field(i))
+		}
+	}
+	return mt
+}
+
+// With returns a copy of mt with the attribute configuring the given kind replaced by
+// attr. Kinds sharing a configuration (for example reflect.Int and reflect.Int64) are
+// all affected. The copy is returned unchanged when the kind is not supported or attr
+// cannot be assigned to the field for that kind (for example a SliceAttributes for
+// reflect.String).
+//
+// Parameters:
+//   - kind: The reflect.Kind whose configuration is replaced
+//   - attr: The new attribute configuration
+//
+// Returns the updated FTAttributes for method chaining.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes().
+//	    With(reflect.Int, IntegerAttributesImpl[int]{Min: 0, Max: 10}).
+//	    With(reflect.String, StringAttributes{MinLen: 3, MaxLen: 3})
+func (mt FTAttributes) With(kind reflect.Kind, attr Attributes) FTAttributes {
+	name, ok := kindFields[kind]
+	if !ok || attr == nil {
+		return mt
 	}
+	field := reflect.ValueOf(&mt).Elem().FieldByName(name)
+	value := reflect.ValueOf(attr)
+	if value.Type().AssignableTo(field.Type()) {
+		field.Set(value)
+	}
+	return mt
 }
 
 // GetAttributeGivenType returns the appropriate Attributes implementation for the given
@@ -198,7 +401,8 @@ func NewFTAttributes() FTAttributes {
 // the fuzz testing framework to determine how to generate random values for function parameters.
 //
 // The method performs the following:
-// 1. Maps the type's Kind to the corresponding attribute configuration
+// 1. Returns the attributes registered for the exact type in TypeAttrs, if any, or else
+// maps the type's Kind to the corresponding attribute configuration
 // 2. Checks if the attribute has custom configuration or needs defaults
 // 3. Returns a fully configured Attributes instance ready for value generation
 //
@@ -212,6 +416,7 @@ func NewFTAttributes() FTAttributes {
 // Errors returned:
 //   - NilTypeError: When t is nil
 //   - UnsupportedAttributeTypeError: When the type's Kind is not supported
+//   - InvalidAttributeError: When the configured attribute implements Validator and rejects its configuration
 //
 // Example usage:
 //
@@ -224,31 +429,179 @@ func (mt FTAttributes) GetAttributeGivenType(t reflect.Type) (retA Attributes, e
 	if t == nil {
 		return nil, NilTypeError{}
 	}
-	kindMap := map[reflect.Kind]Attributes{
-		reflect.Int: mt.IntegerAttr, reflect.Int8: mt.IntegerAttr, reflect.Int16: mt.IntegerAttr, reflect.Int32: mt.IntegerAttr, reflect.Int64: mt.IntegerAttr,
-		reflect.Uint: mt.UIntegerAttr, reflect.Uint8: mt.UIntegerAttr, reflect.Uint16: mt.UIntegerAttr, reflect.Uint32: mt.UIntegerAttr, reflect.Uint64: mt.UIntegerAttr,
-		reflect.Float32: mt.FloatAttr, reflect.Float64: mt.FloatAttr,
-		reflect.Complex64: mt.ComplexAttr, reflect.Complex128: mt.ComplexAttr,
-		reflect.String: mt.StringAttr, reflect.Slice: mt.SliceAttr, reflect.Bool: mt.BoolAttr,
-		reflect.Map: mt.MapAttr, reflect.Pointer: mt.PointerAttr, reflect.Struct: mt.StructAttr, reflect.Array: mt.ArrayAttr,
+	if registered, ok := mt.TypeAttrs[t]; ok {
+		if v, ok := registered.(Validator); ok {
+			if err = v.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		return mt.withGenerationSettings(registered), nil
+	}
+	retA = mt.kindAttributes(t.Kind())
+	if fa, ok := retA.(FuncAttributes); ok {
+		retA = fa.forType(t)
+	}
+	if t == anyType {
+		retA = mt.JSONAttr
+		if mt.PointerAttr.MaxDepth > 0 {
+			retA = mt.PointerAttr
+		}
+	} else if t == contextType {
+		retA = mt.ContextAttr
+	} else if t.Kind() == reflect.Interface {
+		if retA = mt.implementationOf(t); retA != nil && mt.InterfaceAttr.enabled() {
+			return mt.InterfaceAttr.wrap(mt, t, retA)
+		}
+	} else if t.Kind() == reflect.String && len(mt.WordListAttr.Words) > 0 {
+		retA = mt.WordListAttr
 	}
-	retA = kindMap[t.Kind()]
 	if retA == nil {
-		retA, err = mt.getDefaultForKind(t.Kind())
-		return
+		return mt.getDefaultForKind(t.Kind())
 	}
 	attrsVal := retA.GetAttributes()
 	if attrsVal == nil {
-		retA = retA.GetDefaultImplementation()
-		return
+		return mt.withGenerationSettings(retA.GetDefaultImplementation()), nil
 	}
 	attrsValType := reflect.TypeOf(attrsVal)
 
 	zero := reflect.Zero(attrsValType).Interface()
 	if reflect.DeepEqual(attrsVal, zero) {
-		retA = retA.GetDefaultImplementation()
+		return mt.withGenerationSettings(retA.GetDefaultImplementation()), nil
+	}
+	if v, ok := retA.(Validator); ok {
+		if err = v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if pa, ok := retA.(PointerAttributes); ok {
+		retA = pa.forType(t)
+	}
+	return mt.withGenerationSettings(retA), nil
+}
+
+// implementationOf returns the struct or pointer attributes whose generated values
+// implement the interface type t: a StructAttr whose TargetType implements t, or else a
+// PointerAttr whose pointer type does (for methods declared on a pointer receiver). It
+// returns nil when neither does.
+func (mt FTAttributes) implementationOf(t reflect.Type) Attributes {
+	if target := mt.StructAttr.TargetType; target != nil && target.Implements(t) {
+		return mt.StructAttr
+	}
+	if pt := mt.PointerAttr.fixedDepth().GetReflectType(); pt != nil && pt.Implements(t) {
+		return mt.PointerAttr
+	}
+	return nil
+}
+
+// InterfaceAttributes mixes nil and typed nil values into the values generated for
+// parameters of interface types other than any, which otherwise always hold a value of
+// the StructAttr or PointerAttr type implementing the interface.
+//
+// A typed nil is the subtle case behind a classic Go bug: an interface value holding a
+// nil pointer, such as a nil *MyError returned as an error, is not itself nil, so a
+// check like `if err != nil` takes the error branch and calling a method on it may
+// dereference the nil pointer. Generating typed nils exercises exactly that path.
+//
+// Fields:
+//   - NilProbability: Probability of a nil interface value
+//   - TypedNilProbability: Probability of a non-nil interface value holding a nil pointer
+//     of the implementing type (*T for a StructAttr of type T, since *T has all the
+//     methods of T)
+//
+// The probabilities must lie in [0, 1] and add up to at most 1; the remaining values are
+// generated by the implementing attributes as usual.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes()
+//	attrs.PointerAttr = PointerAttributes{Depth: 1, Inner: StructAttributes{TargetType: reflect.TypeOf(MyError{})}}
+//	attrs.InterfaceAttr = InterfaceAttributes{NilProbability: 0.2, TypedNilProbability: 0.2}
+//	// a func(err error) parameter now receives nil, (*MyError)(nil) or a &MyError{...}
+type InterfaceAttributes struct {
+	NilProbability      float64
+	TypedNilProbability float64
+}
+
+// enabled reports whether nil or typed nil values were requested.
+func (a InterfaceAttributes) enabled() bool {
+	return a.NilProbability != 0 || a.TypedNilProbability != 0
+}
+
+// Validate reports an InvalidAttributeError when a probability lies outside [0, 1] or
+// both add up to more than 1.
+func (a InterfaceAttributes) Validate() error {
+	for _, p := range []float64{a.NilProbability, a.TypedNilProbability} {
+		if p < 0 || p > 1 {
+			return InvalidAttributeError{Attribute: "InterfaceAttributes", Reason: "probabilities must lie in [0, 1]"}
+		}
+	}
+	if a.NilProbability+a.TypedNilProbability > 1 {
+		return InvalidAttributeError{Attribute: "InterfaceAttributes", Reason: "NilProbability and TypedNilProbability add up to more than 1"}
+	}
+	return nil
+}
+
+// wrap returns attributes generating values of the interface type t from impl, the
+// attributes of its implementation, mixed with nil and typed nil values.
+func (a InterfaceAttributes) wrap(mt FTAttributes, t reflect.Type, impl Attributes) (Attributes, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	if v, ok := impl.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if pa, ok := impl.(PointerAttributes); ok {
+		impl = pa.forType(t)
+	}
+	return interfaceValueAttributes{iface: t, impl: mt.withGenerationSettings(impl), cfg: a}, nil
+}
+
+// interfaceValueAttributes generates values of an interface type: nil, a nil pointer of
+// the implementing type, or a value generated by impl.
+type interfaceValueAttributes struct {
+	iface reflect.Type
+	impl  Attributes
+	cfg   InterfaceAttributes
+}
+
+func (ia interfaceValueAttributes) GetAttributes() any                   { return ia }
+func (ia interfaceValueAttributes) GetReflectType() reflect.Type         { return ia.iface }
+func (ia interfaceValueAttributes) GetDefaultImplementation() Attributes { return ia }
+func (ia interfaceValueAttributes) GetRandomValue() any {
+	r := rng.Float64()
+	if r < ia.cfg.NilProbability {
+		return nil
+	}
+	if r < ia.cfg.NilProbability+ia.cfg.TypedNilProbability {
+		if pt := ia.typedNilType(); pt != nil {
+			return reflect.Zero(pt).Interface()
+		}
+	}
+	return ia.impl.GetRandomValue()
+}
+
+// withGeneration returns a copy whose implementation is generated under g
+func (ia interfaceValueAttributes) withGeneration(g generation) Attributes {
+	ia.impl = bindGeneration(ia.impl, g).(Attributes)
+	return ia
+}
+
+// typedNilType returns the pointer type whose nil value implements the interface, or nil
+// when the implementation is neither a pointer nor a type whose pointer implements it.
+func (ia interfaceValueAttributes) typedNilType() reflect.Type {
+	t := ia.impl.GetReflectType()
+	if t == nil {
+		return nil
+	}
+	if t.Kind() != reflect.Pointer {
+		t = reflect.PointerTo(t)
 	}
-	return
+	if !t.Implements(ia.iface) {
+		return nil
+	}
+	return t
 }
 
 // getDefaultForKind returns a default Attributes implementation for the given reflect.Kind.
@@ -348,7 +701,7 @@ func (a IntegerAttributesImpl[T]) GetRandomValue() any {
 
 // isValidRange checks if the min/max range is valid
 func (a IntegerAttributesImpl[T]) isValidRange(zero T) bool {
-	return a.Max > zero && a.Min <= a.Max
+	return a.Min <= a.Max
 }
 
 // getMinMaxAsInt64 converts min and max to int64 for calculation
@@ -358,9 +711,16 @@ func (a IntegerAttributesImpl[T]) getMinMaxAsInt64() (int64, int64) {
 	return minVal.Int(), maxVal.Int()
 }
 
+// intRange returns the configured range as int64 and whether it is valid
+func (a IntegerAttributesImpl[T]) intRange() (int64, int64, bool) {
+	var zero T
+	min, max := a.getMinMaxAsInt64()
+	return min, max, a.isValidRange(zero)
+}
+
 // generateRandomInteger generates a random integer within the range and converts back to type T
 func (a IntegerAttributesImpl[T]) generateRandomInteger(min, max int64, zero T) any {
-	result := min + rand.Int63n(max-min+1)
+	result := min + rng.Int63n(max-min+1)
 	resultVal := reflect.ValueOf(result).Convert(reflect.TypeOf(zero))
 	return resultVal.Interface()
 }
@@ -442,10 +802,17 @@ func (a UnsignedIntegerAttributesImpl[T]) getMinMaxAsUint64() (uint64, uint64) {
 	return minVal.Uint(), maxVal.Uint()
 }
 
+// intRange returns the configured range as int64 and whether it is valid and fits in an int64
+func (a UnsignedIntegerAttributesImpl[T]) intRange() (int64, int64, bool) {
+	var zero T
+	min, max := a.getMinMaxAsUint64()
+	return int64(min), int64(max), a.isValidRange(zero) && max > min && max <= math.MaxInt64
+}
+
 // generateRandomUnsignedInteger generates a random unsigned integer within the range and converts back to type T
 func (a UnsignedIntegerAttributesImpl[T]) generateRandomUnsignedInteger(min, max uint64, zero T) any {
 	diff := max - min + 1
-	result := min + uint64(rand.Int63n(int64(diff)))
+	result := min + uint64(rng.Int63n(int64(diff)))
 	resultVal := reflect.ValueOf(result).Convert(reflect.TypeOf(zero))
 	return resultVal.Interface()
 }
@@ -464,6 +831,18 @@ func (a UnsignedIntegerAttributesImpl[T]) generateRandomUnsignedInteger(min, max
 //   - AllowNaN: If true, NaN values can be generated (requires FiniteOnly to be false)
 //   - AllowInf: If true, Infinity values can be generated (requires FiniteOnly to be false)
 //   - Precision: Number of decimal places for rounding (0 means no rounding)
+//   - LogScale: If true, values are sampled uniformly in log space so that every order of
+//     magnitude between Min and Max is equally represented (requires Min > 0)
+//   - ExcludeMin, ExcludeMax: When ExcludeMin < ExcludeMax, values in the open interval
+//     (ExcludeMin, ExcludeMax) are never generated; the hole must lie within [Min, Max]
+//     and leave part of the range available
+//   - Step: If positive, values are quantized to the multiples of Step within [Min, Max],
+//     each equally likely, so that generated values repeat; this exercises equality and
+//     deduplication logic that full-precision values almost never reach. It cannot be
+//     combined with LogScale; an infinite Step, which Validate rejects, generates zero
+//
+// Values are drawn directly from the parts of the range around the hole, so excluding
+// a sub-range needs no resampling.
 //
 // Example usage:
 //
@@ -483,6 +862,12 @@ type FloatAttributesImpl[T Floats] struct {
 	AllowNaN   bool
 	AllowInf   bool
 	Precision  uint
+	LogScale   bool
+	ExcludeMin T
+	ExcludeMax T
+	Step       float64
+
+	gen generation
 }
 
 func (a FloatAttributesImpl[T]) GetAttributes() any           { return a }
@@ -498,18 +883,129 @@ func (a FloatAttributesImpl[T]) GetDefaultImplementation() Attributes {
 
 func (a FloatAttributesImpl[T]) GetRandomValue() any {
 	var zero T
-	if !a.isValidRange() {
+	if !a.isValidRange() || math.IsInf(a.Step, 1) {
 		return zero
 	}
 
 	min, max := a.getMinMaxAsFloat64()
-	result := a.generateRandomFloat(min, max)
-	return a.convertToTargetType(result, zero)
+	draw := func() any {
+		if a.Step > 0 {
+			return a.convertToTargetType(a.generateSteppedFloat(min, max), zero)
+		}
+		if a.LogScale {
+			return a.convertToTargetType(a.generateLogScaleFloat(min, max), zero)
+		}
+		return a.convertToTargetType(a.generateRandomFloat(min, max), zero)
+	}
+	if !a.NonZero {
+		return draw()
+	}
+	if v, ok := a.gen.generateUntil(
+		func() string { return "NonZero" },
+		func(v any) bool { return v.(T) != 0 },
+		draw,
+	); ok {
+		return v
+	}
+	return zero
+}
+
+// withRetries returns a copy of the attributes that gives up on NonZero after the retry
+// limit of g
+func (a FloatAttributesImpl[T]) withRetries(g generation) Attributes {
+	a.gen = g
+	return a
+}
+
+// Validate reports an InvalidAttributeError when LogScale is enabled for a range
+// that is not strictly positive, when the excluded sub-range is inverted, extends
+// past [Min, Max] or leaves nothing of it to generate, or when Step is negative, is
+// combined with LogScale or does not split the range into a sensible number of values.
+func (a FloatAttributesImpl[T]) Validate() error {
+	if a.LogScale && a.Min <= 0 {
+		return InvalidAttributeError{Attribute: "FloatAttributes", Reason: "LogScale requires Min > 0"}
+	}
+	if err := a.validateStep(); err != nil {
+		return err
+	}
+	if a.ExcludeMin > a.ExcludeMax {
+		return InvalidAttributeError{Attribute: "FloatAttributes", Reason: "ExcludeMin must not exceed ExcludeMax"}
+	}
+	if _, _, ok := a.hole(); ok {
+		if a.ExcludeMin < a.Min || a.ExcludeMax > a.Max {
+			return InvalidAttributeError{Attribute: "FloatAttributes", Reason: "excluded range must lie within [Min, Max]"}
+		}
+		if a.ExcludeMin == a.Min && a.ExcludeMax == a.Max {
+			return InvalidAttributeError{Attribute: "FloatAttributes", Reason: "excluded range leaves no values to generate"}
+		}
+	}
+	return nil
+}
+
+// maxSteps is the largest number of multiples of Step a range may hold; past it the
+// values are too fine to ever repeat and quantization is pointless.
+const maxSteps = 1 << 53
+
+// validateStep checks that Step is not negative or combined with LogScale, and that
+// [Min, Max] holds between one and maxSteps of its multiples outside the hole.
+func (a FloatAttributesImpl[T]) validateStep() error {
+	if a.Step == 0 {
+		return nil
+	}
+	if !(a.Step > 0) || math.IsInf(a.Step, 1) {
+		return InvalidAttributeError{Attribute: "FloatAttributes", Reason: "Step must be a positive finite number"}
+	}
+	if a.LogScale {
+		return InvalidAttributeError{Attribute: "FloatAttributes", Reason: "Step cannot be combined with LogScale"}
+	}
+	min, max := a.getMinMaxAsFloat64()
+	if (max-min)/a.Step > maxSteps {
+		return InvalidAttributeError{Attribute: "FloatAttributes", Reason: fmt.Sprintf("Step %v is too small for the range [%v, %v]", a.Step, min, max)}
+	}
+	if below, above := a.stepsAroundHole(min, max); below+above < 1 {
+		return InvalidAttributeError{Attribute: "FloatAttributes", Reason: fmt.Sprintf("no multiple of Step %v lies in the range [%v, %v]", a.Step, min, max)}
+	}
+	return nil
+}
+
+// stepsAroundHole returns the number of multiples of Step in [min, max] lying at or
+// below the hole and at or above it; without a hole they are all counted below.
+func (a FloatAttributesImpl[T]) stepsAroundHole(min, max float64) (below, above float64) {
+	first, last := math.Ceil(min/a.Step), math.Floor(max/a.Step)
+	lo, hi, ok := a.hole()
+	if !ok {
+		return math.Max(last-first+1, 0), 0
+	}
+	below = math.Max(math.Min(math.Floor(lo/a.Step), last)-first+1, 0)
+	above = math.Max(last-math.Max(math.Ceil(hi/a.Step), first)+1, 0)
+	return below, above
+}
+
+// generateSteppedFloat draws uniformly among the multiples of Step in [min, max] that lie
+// outside the hole, returning min when there are none. The result is clamped to the
+// range in case rounding pushed a multiple just past its ends
+func (a FloatAttributesImpl[T]) generateSteppedFloat(min, max float64) float64 {
+	below, above := a.stepsAroundHole(min, max)
+	if below+above < 1 {
+		return min
+	}
+	k := float64(rng.Int63n(int64(below + above)))
+	multiple := math.Ceil(min/a.Step) + k
+	if k >= below {
+		_, hi, _ := a.hole()
+		multiple = math.Max(math.Ceil(hi/a.Step), math.Ceil(min/a.Step)) + k - below
+	}
+	return math.Min(math.Max(multiple*a.Step, min), max)
+}
+
+// hole returns the excluded open interval as float64 and whether one is configured
+func (a FloatAttributesImpl[T]) hole() (float64, float64, bool) {
+	return float64(a.ExcludeMin), float64(a.ExcludeMax), a.ExcludeMin < a.ExcludeMax
 }
 
 // isValidRange checks if the min/max range is valid
 func (a FloatAttributesImpl[T]) isValidRange() bool {
-	return a.Max > a.Min
+	return a.Max > a.Min && (!a.LogScale || a.Min > 0)
 }
 
 // getMinMaxAsFloat64 converts min and max to float64 for calculation
@@ -519,9 +1015,50 @@ func (a FloatAttributesImpl[T]) getMinMaxAsFloat64() (float64, float64) {
 	return minVal.Float(), maxVal.Float()
 }
 
-// generateRandomFloat generates a random float within the range
+// floatRange returns the configured range as float64 and whether it is valid
+func (a FloatAttributesImpl[T]) floatRange() (float64, float64, bool) {
+	min, max := a.getMinMaxAsFloat64()
+	return min, max, a.isValidRange()
+}
+
+// generateRandomFloat generates a random float within the range, outside the hole
 func (a FloatAttributesImpl[T]) generateRandomFloat(min, max float64) float64 {
-	return min + rand.Float64()*(max-min)
+	if lo, hi, ok := a.hole(); ok {
+		return uniformOutside(min, max, lo, hi)
+	}
+	return min + rng.Float64()*(max-min)
+}
+
+// generateLogScaleFloat generates a random float whose logarithm is uniform in
+// [log(min), log(max)], outside the logarithm of the hole
+func (a FloatAttributesImpl[T]) generateLogScaleFloat(min, max float64) float64 {
+	var logResult float64
+	if lo, hi, ok := a.hole(); ok {
+		lo, hi = clamp(lo, min, max), clamp(hi, min, max)
+		logResult = uniformOutside(math.Log(min), math.Log(max), math.Log(lo), math.Log(hi))
+	} else {
+		logResult = math.Log(min) + rng.Float64()*(math.Log(max)-math.Log(min))
+	}
+	return math.Min(math.Max(math.Exp(logResult), min), max)
+}
+
+// uniformOutside draws uniformly from [min, lo] and [hi, max] taken together by mapping a
+// single draw over their combined length, so that no value is rejected. The hole is
+// clamped to the range first, as Validate may have been skipped, so the result always
+// lies in [min, max]; it is max when the hole covers the whole range
+func uniformOutside(min, max, lo, hi float64) float64 {
+	lo, hi = clamp(lo, min, max), clamp(hi, min, max)
+	left := lo - min
+	u := rng.Float64() * (left + max - hi)
+	if u < left {
+		return min + u
+	}
+	return hi + (u - left)
+}
+
+// clamp limits v to [min, max]
+func clamp(v, min, max float64) float64 {
+	return math.Min(math.Max(v, min), max)
 }
 
 // convertToTargetType converts the result back to the target type T
@@ -607,12 +1144,12 @@ func (a ComplexAttributesImpl[T]) getBounds() (float64, float64, float64, float6
 
 // generateRandomReal generates a random real part
 func (a ComplexAttributesImpl[T]) generateRandomReal(min, max float64) float64 {
-	return min + rand.Float64()*(max-min)
+	return min + rng.Float64()*(max-min)
 }
 
 // generateRandomImaginary generates a random imaginary part
 func (a ComplexAttributesImpl[T]) generateRandomImaginary(min, max float64) float64 {
-	return min + rand.Float64()*(max-min)
+	return min + rng.Float64()*(max-min)
 }
 
 // createComplexValue creates and converts the complex value to target type
@@ -633,7 +1170,19 @@ func (a ComplexAttributesImpl[T]) createComplexValue(realPart, imagPart float64,
 //   - Prefix: String to prepend to all generated strings
 //   - Suffix: String to append to all generated strings
 //   - Contains: Substring that must appear in all generated strings
-//   - UniqueChars: If true, all characters in generated strings must be unique
+//   - UniqueChars: If true, all randomly generated characters are distinct (Prefix, Suffix
+//     and templates are not affected)
+//   - Template: Optional format template; when set, it replaces length and character set
+//     generation. '#' expands to a random digit, '@' to a random ASCII letter, and any
+//     other character is copied literally. A backslash escapes the next character, so
+//     `\#` and `\@` produce a literal '#' and '@'
+//   - AllowInvalidUTF8: If true, about half of the generated strings have one to three
+//     invalid UTF-8 byte sequences spliced in (stray continuation bytes, overlong and
+//     truncated encodings, surrogates) on top of the configured length
+//   - LengthDistribution: How the length is sampled from [MinLen, MaxLen]; uniform by
+//     default, or exponential or weighted to favor short strings with occasional long ones
+//
+// Generated strings are valid UTF-8 unless AllowInvalidUTF8 is set.
 //
 // Example usage:
 //
@@ -644,15 +1193,23 @@ func (a ComplexAttributesImpl[T]) createComplexValue(realPart, imagPart float64,
 //	    AllowedRunes: []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"),
 //	}
 //	randomString := attrs.GetRandomValue() // Returns a random string like "aBc3Def9Gh"
+//
+//	// Generate readable identifiers such as "user-042-xQz"
+//	idAttrs := StringAttributes{Template: "user-###-@@@"}
 type StringAttributes struct {
-	MinLen       int
-	MaxLen       int
-	AllowedRunes []rune
-	Regex        string
-	Prefix       string
-	Suffix       string
-	Contains     string
-	UniqueChars  bool
+	MinLen             int
+	MaxLen             int
+	AllowedRunes       []rune
+	Regex              string
+	Prefix             string
+	Suffix             string
+	Contains           string
+	UniqueChars        bool
+	Template           string
+	AllowInvalidUTF8   bool
+	LengthDistribution LengthDistribution
+
+	gen generation
 }
 
 func (a StringAttributes) GetAttributes() any           { return a }
@@ -660,23 +1217,61 @@ func (a StringAttributes) GetReflectType() reflect.Type { return reflect.TypeOf(
 func (a StringAttributes) GetDefaultImplementation() Attributes {
 	return StringAttributes{
 		MinLen: 1,
-		MaxLen: 10,
+		MaxLen: DefaultMaxStringLen,
 	}
 }
 
 func (a StringAttributes) GetRandomValue() any {
-	minLen, maxLen := a.getLengthBounds()
-	length := a.pickLength(minLen, maxLen)
-	allowedRunes := a.getAllowedRunes()
-	generated := a.generateRandomString(allowedRunes, length)
+	var generated string
+	if a.Template != "" {
+		generated = a.expandTemplate()
+	} else {
+		minLen, maxLen := a.getLengthBounds()
+		length := a.pickLength(minLen, maxLen)
+		allowedRunes := a.getAllowedRunes()
+		var ok bool
+		if generated, ok = a.generateRandomString(allowedRunes, length); !ok {
+			return ""
+		}
+	}
+	if a.AllowInvalidUTF8 && rng.Intn(2) == 0 {
+		generated = a.injectInvalidUTF8(generated)
+	}
 	return a.applyPrefixSuffix(generated)
 }
 
+// invalidUTF8Sequences are byte sequences that are never valid UTF-8
+var invalidUTF8Sequences = []string{
+	"\x80",             // stray continuation byte
+	"\xff",             // byte that never appears in UTF-8
+	"\xc0\xaf",         // overlong encoding of '/'
+	"\xe2\x82",         // truncated three-byte sequence
+	"\xed\xa0\x80",     // UTF-16 surrogate half
+	"\xf8\x88\x80\x80", // obsolete five-byte lead
+}
+
+// injectInvalidUTF8 splices one to three invalid byte sequences into s at distinct rune
+// boundaries, so that no two injected sequences are adjacent
+func (a StringAttributes) injectInvalidUTF8(s string) string {
+	boundaries := make([]int, 0, len(s)+1)
+	for i := range s {
+		boundaries = append(boundaries, i)
+	}
+	boundaries = append(boundaries, len(s))
+	chosen := rng.Perm(len(boundaries))[:min(1+rng.Intn(3), len(boundaries))]
+	sort.Sort(sort.Reverse(sort.IntSlice(chosen)))
+	for _, idx := range chosen {
+		at := boundaries[idx]
+		s = s[:at] + invalidUTF8Sequences[rng.Intn(len(invalidUTF8Sequences))] + s[at:]
+	}
+	return s
+}
+
 // getLengthBounds returns validated min and max length bounds
 func (a StringAttributes) getLengthBounds() (int, int) {
 	minLen, maxLen := a.MinLen, a.MaxLen
 	if maxLen <= 0 {
-		maxLen = 10
+		maxLen = DefaultMaxStringLen
 	}
 	if minLen < 0 {
 		minLen = 0
@@ -687,12 +1282,19 @@ func (a StringAttributes) getLengthBounds() (int, int) {
 	return minLen, maxLen
 }
 
-// pickLength picks a random length between minLen and maxLen
+// pickLength picks a random length between minLen and maxLen from the LengthDistribution
 func (a StringAttributes) pickLength(minLen, maxLen int) int {
-	if maxLen > minLen {
-		return minLen + rand.Intn(maxLen-minLen+1)
+	return a.LengthDistribution.pick(minLen, maxLen)
+}
+
+// Validate reports an InvalidAttributeError when the LengthDistribution cannot sample
+// the length bounds.
+func (a StringAttributes) Validate() error {
+	minLen, maxLen := a.getLengthBounds()
+	if reason := a.LengthDistribution.validate(minLen, maxLen); reason != "" {
+		return InvalidAttributeError{Attribute: "StringAttributes", Reason: "LengthDistribution: " + reason}
 	}
-	return minLen
+	return nil
 }
 
 // getAllowedRunes returns the allowed runes, defaulting to ASCII printable if empty
@@ -706,11 +1308,61 @@ func (a StringAttributes) getAllowedRunes() []rune {
 	return allowedRunes
 }
 
-// generateRandomString generates a random string of given length using allowed runes
-func (a StringAttributes) generateRandomString(allowedRunes []rune, length int) string {
+// generateRandomString generates a random string of given length using allowed runes,
+// redrawing runes that were already used when UniqueChars is set. It reports false when
+// no unused rune could be drawn
+func (a StringAttributes) generateRandomString(allowedRunes []rune, length int) (string, bool) {
 	result := make([]rune, length)
+	used := make(map[rune]bool, length)
+	draw := func() any { return allowedRunes[rng.Intn(len(allowedRunes))] }
 	for i := range length {
-		result[i] = allowedRunes[rand.Intn(len(allowedRunes))]
+		if !a.UniqueChars {
+			result[i] = draw().(rune)
+			continue
+		}
+		r, ok := a.gen.generateUntil(
+			func() string { return "UniqueChars" },
+			func(v any) bool { return !used[v.(rune)] },
+			draw,
+		)
+		if !ok {
+			return "", false
+		}
+		result[i] = r.(rune)
+		used[result[i]] = true
+	}
+	return string(result), true
+}
+
+// withRetries returns a copy of the attributes that gives up on UniqueChars after the
+// retry limit of g
+func (a StringAttributes) withRetries(g generation) Attributes {
+	a.gen = g
+	return a
+}
+
+// expandTemplate replaces the template metacharacters with random characters
+func (a StringAttributes) expandTemplate() string {
+	const (
+		digits  = "0123456789"
+		letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	)
+	template := []rune(a.Template)
+	result := make([]rune, 0, len(template))
+	for i := 0; i < len(template); i++ {
+		switch template[i] {
+		case '\\':
+			if i+1 < len(template) {
+				i++
+			}
+			result = append(result, template[i])
+		case '#':
+			result = append(result, rune(digits[rng.Intn(len(digits))]))
+		case '@':
+			result = append(result, rune(letters[rng.Intn(len(letters))]))
+		default:
+			result = append(result, template[i])
+		}
 	}
 	return string(result)
 }
@@ -732,10 +1384,17 @@ func (a StringAttributes) applyPrefixSuffix(generated string) string {
 // Fields:
 //   - MinLen: Minimum slice length (inclusive)
 //   - MaxLen: Maximum slice length (inclusive)
-//   - Unique: If true, all slice elements must be unique
-//   - Sorted: If true, generated slices are sorted
-//   - ElementPreds: Predicates that all elements must satisfy
+//   - MaxCap: Maximum slice capacity; when greater than the generated length, the capacity
+//     is picked at random in [length, MaxCap] so that cap(s) > len(s) can be exercised
+//   - Unique: If true, all slice elements are distinct; duplicates are redrawn, and after
+//     GenerationConfig.MaxRetries consecutive duplicates (100 by default) the slice is
+//     shortened to the distinct values found, as when the element domain is too small
+//   - Sorted: If true, generated slices are sorted in ascending order (integer, float and string elements)
+//   - ElementPreds: Predicates that all elements must satisfy; elements are redrawn until
+//     they do, up to the retry budget of GenerationConfig
 //   - ElementAttrs: Attributes for generating slice elements (can be Attributes or reflect.Type)
+//   - Less: Optional comparator used by Sorted instead of the built-in ordering, which
+//     allows sorting structs and other custom element types
 //
 // Example usage:
 //
@@ -749,10 +1408,14 @@ func (a StringAttributes) applyPrefixSuffix(generated string) string {
 type SliceAttributes struct {
 	MinLen       int
 	MaxLen       int
+	MaxCap       int
 	Unique       bool
 	Sorted       bool
 	ElementPreds []p.Predicate
 	ElementAttrs any
+	Less         func(a, b any) bool
+
+	gen generation
 }
 
 func (a SliceAttributes) GetAttributes() any { return a }
@@ -775,20 +1438,37 @@ func (a SliceAttributes) GetReflectType() reflect.Type {
 func (a SliceAttributes) GetDefaultImplementation() Attributes {
 	return SliceAttributes{
 		MinLen:       1,
-		MaxLen:       5,
+		MaxLen:       DefaultMaxSliceLen,
 		ElementAttrs: IntegerAttributesImpl[int]{},
 	}
 }
 
 func (a SliceAttributes) GetRandomValue() any {
 	minLen, maxLen := a.getSliceLengthBounds()
-	length := a.pickSliceLength(minLen, maxLen)
 	elemType := a.getElementType()
 	if elemType == nil {
 		return nil
 	}
+	if !a.gen.checkLength("slice length", maxLen) || !a.gen.checkLength("slice capacity", a.MaxCap) {
+		return reflect.Zero(reflect.SliceOf(elemType)).Interface()
+	}
+	length := a.pickSliceLength(minLen, maxLen)
+	if a.gen.tooDeep() {
+		length = 0
+	}
+	if a.Unique {
+		elements := a.generateUniqueElements(elemType, length)
+		result := a.makeSliceOfType(elemType, len(elements))
+		for i, elem := range elements {
+			result.Index(i).Set(elem)
+		}
+		return result.Interface()
+	}
 	result := a.makeSliceOfType(elemType, length)
-	a.fillSliceWithRandomElements(result, elemType, length)
+	result = a.fillSliceWithRandomElements(result, elemType, length)
+	if a.Sorted {
+		a.sortSlice(result)
+	}
 	return result.Interface()
 }
 
@@ -797,7 +1477,7 @@ func (a SliceAttributes) getSliceLengthBounds() (int, int) {
 	minLen := a.MinLen
 	maxLen := a.MaxLen
 	if maxLen <= 0 {
-		maxLen = 5
+		maxLen = DefaultMaxSliceLen
 	}
 	if minLen < 0 {
 		minLen = 0
@@ -811,7 +1491,7 @@ func (a SliceAttributes) getSliceLengthBounds() (int, int) {
 // pickSliceLength picks a random length between minLen and maxLen.
 func (a SliceAttributes) pickSliceLength(minLen, maxLen int) int {
 	if maxLen > minLen {
-		return minLen + rand.Intn(maxLen-minLen+1)
+		return minLen + rng.Intn(maxLen-minLen+1)
 	}
 	return minLen
 }
@@ -827,25 +1507,134 @@ func (a SliceAttributes) getElementType() reflect.Type {
 // makeSliceOfType creates a slice of the given type and length.
 func (a SliceAttributes) makeSliceOfType(elemType reflect.Type, length int) reflect.Value {
 	sliceType := reflect.SliceOf(elemType)
-	return reflect.MakeSlice(sliceType, length, length)
+	return reflect.MakeSlice(sliceType, length, a.pickSliceCap(length))
+}
+
+// pickSliceCap picks a random capacity between length and MaxCap, never below length.
+func (a SliceAttributes) pickSliceCap(length int) int {
+	if a.MaxCap > length {
+		return length + rng.Intn(a.MaxCap-length+1)
+	}
+	return length
 }
 
-// fillSliceWithRandomElements fills the slice with random elements.
-func (a SliceAttributes) fillSliceWithRandomElements(result reflect.Value, elemType reflect.Type, length int) {
+// fillSliceWithRandomElements fills the slice with random elements and returns it,
+// truncated to the elements generated before the element budget ran out.
+func (a SliceAttributes) fillSliceWithRandomElements(result reflect.Value, elemType reflect.Type, length int) reflect.Value {
 	for i := range length {
-		var elemValue reflect.Value
-		if attrs, ok := a.ElementAttrs.(Attributes); ok {
-			randVal := attrs.GetRandomValue()
-			if randVal != nil {
-				elemValue = reflect.ValueOf(randVal)
-			} else {
-				elemValue = reflect.Zero(elemType)
-			}
+		if a.gen.budget.exhausted() {
+			return result.Slice(0, i)
+		}
+		result.Index(i).Set(a.generateElement(elemType))
+	}
+	return result
+}
+
+// withGeneration returns a copy of the attributes whose elements are generated one
+// nesting level below g.
+func (a SliceAttributes) withGeneration(g generation) Attributes {
+	if !g.tooDeep() {
+		a.ElementAttrs = bindGeneration(a.ElementAttrs, g.nested())
+	}
+	a.gen = g
+	return a
+}
+
+// generateElement generates a single random element, falling back to the zero value.
+func (a SliceAttributes) generateElement(elemType reflect.Type) reflect.Value {
+	if attrs, ok := a.ElementAttrs.(Attributes); ok {
+		if randVal := drawSatisfying(attrs, "ElementPreds", a.ElementPreds, a.gen); randVal != nil {
+			return reflect.ValueOf(randVal)
+		}
+	}
+	return reflect.Zero(elemType)
+}
+
+// generateUniqueElements generates up to length distinct elements. When the element
+// domain holds fewer than length values the result is shorter than length.
+//
+// Sorted integer and float ranges without ElementPreds take a direct sampling path that draws distinct
+// values from the range and sorts them once; every other element type falls back to
+// rejection sampling.
+func (a SliceAttributes) generateUniqueElements(elemType reflect.Type, length int) []reflect.Value {
+	if a.Sorted && a.Less == nil && len(a.ElementPreds) == 0 {
+		if elements, ok := a.sampleSortedFromRange(elemType, length); ok {
+			return elements
+		}
+	}
+	elements := a.rejectionSampleUnique(elemType, length)
+	if a.Sorted {
+		a.sortElements(elements)
+	}
+	return elements
+}
+
+// rejectionSampleUnique draws elements until length distinct values have been found or
+// the retry limit of the generation settings is spent on consecutive duplicates.
+func (a SliceAttributes) rejectionSampleUnique(elemType reflect.Type, length int) []reflect.Value {
+	elements := make([]reflect.Value, 0, length)
+	seen := newValueSet(elemType)
+	retries := retryLimit(a.gen.maxRetries)
+	for misses := 0; len(elements) < length && misses < retries && !a.gen.budget.exhausted(); {
+		elem := a.generateElement(elemType)
+		if !seen.add(elem) {
+			misses++
+			continue
+		}
+		misses = 0
+		elements = append(elements, elem)
+	}
+	return elements
+}
+
+// sampleSortedFromRange draws length distinct values directly from an integer or float
+// element range and returns them sorted. It reports false when the element attributes
+// do not describe a numeric range.
+func (a SliceAttributes) sampleSortedFromRange(elemType reflect.Type, length int) ([]reflect.Value, bool) {
+	switch attrs := a.ElementAttrs.(type) {
+	case integerRange:
+		min, max, ok := attrs.intRange()
+		if !ok || uint64(max-min) >= math.MaxInt64 {
+			return nil, false
+		}
+		return convertAll(sampleDistinctInts(min, max, length), elemType), true
+	case floatRange:
+		min, max, ok := attrs.floatRange()
+		if !ok {
+			return nil, false
 		}
-		result.Index(i).Set(elemValue)
+		return convertAll(sampleDistinctFloats(min, max, length, retryLimit(a.gen.maxRetries)), elemType), true
+	}
+	return nil, false
+}
+
+// sortSlice sorts the slice in place when its elements are ordered.
+func (a SliceAttributes) sortSlice(result reflect.Value) {
+	elements := make([]reflect.Value, result.Len())
+	for i := range elements {
+		elements[i] = reflect.ValueOf(result.Index(i).Interface())
+	}
+	a.sortElements(elements)
+	for i, elem := range elements {
+		result.Index(i).Set(elem)
 	}
 }
 
+// sortElements sorts the elements in ascending order using Less when provided, or the
+// built-in ordering when the element type is ordered.
+func (a SliceAttributes) sortElements(elements []reflect.Value) {
+	less := a.Less
+	if less == nil {
+		if len(elements) == 0 || !utils.IsOrdered(elements[0].Type()) {
+			return
+		}
+		less = utils.Less
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		return less(elements[i].Interface(), elements[j].Interface())
+	})
+}
+
 // BoolAttributes configures the generation of random boolean values with options
 // to force specific values.
 //
@@ -897,7 +1686,7 @@ func (a BoolAttributes) getForcedValue() bool {
 
 // generateRandomBool generates a random boolean value
 func (a BoolAttributes) generateRandomBool() bool {
-	return rand.Intn(2) == 1
+	return rng.Intn(2) == 1
 }
 
 // MapAttributes configures the generation of random map values with control over
@@ -906,10 +1695,22 @@ func (a BoolAttributes) generateRandomBool() bool {
 // Fields:
 //   - MinSize: Minimum number of map entries (inclusive)
 //   - MaxSize: Maximum number of map entries (inclusive)
-//   - KeyPreds: Predicates that all keys must satisfy
-//   - ValuePreds: Predicates that all values must satisfy
+//   - KeyPreds: Predicates that all keys must satisfy (redrawn like ElementPreds)
+//   - ValuePreds: Predicates that all values must satisfy (redrawn like ElementPreds)
 //   - KeyAttrs: Attributes for generating map keys (can be Attributes or reflect.Type)
 //   - ValueAttrs: Attributes for generating map values (can be Attributes or reflect.Type)
+//   - UniqueValues: If true, all map values are distinct, which suits bijective maps and
+//     reverse lookups; colliding values are redrawn like colliding keys
+//   - RecordKeyOrder: If set, the insertion order of the keys of every generated map is
+//     recorded in this registry and can be retrieved with its KeyOrder method, so that a
+//     function iterating the map can be replayed in exactly the same order
+//
+// Keys are drawn until the map reaches the chosen size. Colliding keys (and colliding values
+// when UniqueValues is set) are redrawn, up to GenerationConfig.MaxRetries consecutive
+// collisions (100 by default), after which the map is returned smaller than requested. A
+// value domain too small for the chosen size therefore caps the map at the number of
+// distinct values found. When the key attributes expose a finite domain (integer ranges,
+// booleans), Validate reports an error if that domain cannot hold MinSize distinct keys.
 //
 // Example usage:
 //
@@ -922,12 +1723,16 @@ func (a BoolAttributes) generateRandomBool() bool {
 //	}
 //	randomMap := attrs.GetRandomValue() // Returns a random map[string]int
 type MapAttributes struct {
-	MinSize    int
-	MaxSize    int
-	KeyPreds   []p.Predicate
-	ValuePreds []p.Predicate
-	KeyAttrs   any
-	ValueAttrs any
+	MinSize        int
+	MaxSize        int
+	KeyPreds       []p.Predicate
+	ValuePreds     []p.Predicate
+	KeyAttrs       any
+	ValueAttrs     any
+	UniqueValues   bool
+	RecordKeyOrder *KeyOrders
+
+	gen generation
 }
 
 func (a MapAttributes) GetAttributes() any { return a }
@@ -954,7 +1759,7 @@ func (a MapAttributes) GetReflectType() reflect.Type {
 func (a MapAttributes) GetDefaultImplementation() Attributes {
 	return MapAttributes{
 		MinSize: 1,
-		MaxSize: 5,
+		MaxSize: DefaultMaxMapSize,
 		KeyAttrs: StringAttributes{
 			MinLen: 1,
 			MaxLen: 5,
@@ -965,14 +1770,23 @@ func (a MapAttributes) GetDefaultImplementation() Attributes {
 
 func (a MapAttributes) GetRandomValue() any {
 	minSize, maxSize := a.getMapSizeBounds()
-	size := a.pickMapSize(minSize, maxSize)
 	keyType, valueType := a.getKeyValueTypes()
 	if keyType == nil || valueType == nil {
 		return nil
 	}
 	mapType := reflect.MapOf(keyType, valueType)
+	if !a.gen.checkLength("map size", maxSize) {
+		return reflect.Zero(mapType).Interface()
+	}
+	size := a.pickMapSize(minSize, maxSize)
+	if a.gen.tooDeep() {
+		size = 0
+	}
 	result := reflect.MakeMap(mapType)
-	a.fillMapWithRandomEntries(result, keyType, valueType, size)
+	keys := a.fillMapWithRandomEntries(result, keyType, valueType, size)
+	if a.RecordKeyOrder != nil {
+		a.RecordKeyOrder.record(result, keys)
+	}
 	return result.Interface()
 }
 
@@ -981,7 +1795,7 @@ func (a MapAttributes) getMapSizeBounds() (int, int) {
 	minSize := a.MinSize
 	maxSize := a.MaxSize
 	if maxSize <= 0 {
-		maxSize = 5
+		maxSize = DefaultMaxMapSize
 	}
 	if minSize < 0 {
 		minSize = 0
@@ -995,7 +1809,7 @@ func (a MapAttributes) getMapSizeBounds() (int, int) {
 // pickMapSize picks a random size between minSize and maxSize.
 func (a MapAttributes) pickMapSize(minSize, maxSize int) int {
 	if maxSize > minSize {
-		return minSize + rand.Intn(maxSize-minSize+1)
+		return minSize + rng.Intn(maxSize-minSize+1)
 	}
 	return minSize
 }
@@ -1012,19 +1826,79 @@ func (a MapAttributes) getKeyValueTypes() (reflect.Type, reflect.Type) {
 	return keyType, valueType
 }
 
-// fillMapWithRandomEntries fills the map with random key-value pairs.
-func (a MapAttributes) fillMapWithRandomEntries(result reflect.Value, keyType, valueType reflect.Type, size int) {
-	for i := 0; i < size; i++ {
+// Validate reports an InvalidAttributeError when the key attributes cannot produce
+// MinSize distinct keys.
+func (a MapAttributes) Validate() error {
+	minSize, _ := a.getMapSizeBounds()
+	if domain, ok := a.keyDomainSize(); ok && domain < uint64(minSize) {
+		return InvalidAttributeError{
+			Attribute: "MapAttributes",
+			Reason:    fmt.Sprintf("key attributes allow only %d distinct keys, MinSize is %d", domain, minSize),
+		}
+	}
+	return nil
+}
+
+// keyDomainSize returns the number of distinct keys the key attributes can produce,
+// when that number is known.
+func (a MapAttributes) keyDomainSize() (uint64, bool) {
+	switch attrs := a.KeyAttrs.(type) {
+	case BoolAttributes:
+		if attrs.shouldForceValue() {
+			return 1, true
+		}
+		return 2, true
+	case integerRange:
+		min, max, ok := attrs.intRange()
+		size := uint64(max-min) + 1
+		return size, ok && size != 0
+	}
+	return 0, false
+}
+
+// fillMapWithRandomEntries fills the map with random key-value pairs until it holds size
+// distinct keys, giving up after the retry limit of the generation settings is spent on
+// consecutive key collisions (or value collisions when UniqueValues is set) or once the
+// element budget runs out. It returns the keys in insertion order when RecordKeyOrder is
+// set.
+func (a MapAttributes) fillMapWithRandomEntries(result reflect.Value, keyType, valueType reflect.Type, size int) (keys []any) {
+	seenValues := newValueSet(valueType)
+	retries := retryLimit(a.gen.maxRetries)
+	for misses := 0; result.Len() < size && misses < retries && !a.gen.budget.exhausted(); {
 		keyValue := a.getRandomKeyValue(keyType)
+		if result.MapIndex(keyValue).IsValid() {
+			misses++
+			continue
+		}
 		valueValue := a.getRandomValueValue(valueType)
+		if a.UniqueValues && !seenValues.add(valueValue) {
+			misses++
+			continue
+		}
+		misses = 0
 		result.SetMapIndex(keyValue, valueValue)
+		if a.RecordKeyOrder != nil {
+			keys = append(keys, keyValue.Interface())
+		}
 	}
+	return keys
+}
+
+// withGeneration returns a copy of the attributes whose keys and values are generated
+// one nesting level below g.
+func (a MapAttributes) withGeneration(g generation) Attributes {
+	if !g.tooDeep() {
+		a.KeyAttrs = bindGeneration(a.KeyAttrs, g.nested())
+		a.ValueAttrs = bindGeneration(a.ValueAttrs, g.nested())
+	}
+	a.gen = g
+	return a
 }
 
 // getRandomKeyValue returns a random key value.
 func (a MapAttributes) getRandomKeyValue(keyType reflect.Type) reflect.Value {
 	if attrs, ok := a.KeyAttrs.(Attributes); ok {
-		randKey := attrs.GetRandomValue()
+		randKey := drawSatisfying(attrs, "KeyPreds", a.KeyPreds, a.gen)
 		if randKey != nil {
 			return reflect.ValueOf(randKey)
 		}
@@ -1035,7 +1909,7 @@ func (a MapAttributes) getRandomKeyValue(keyType reflect.Type) reflect.Value {
 // getRandomValueValue returns a random value value.
 func (a MapAttributes) getRandomValueValue(valueType reflect.Type) reflect.Value {
 	if attrs, ok := a.ValueAttrs.(Attributes); ok {
-		randValue := attrs.GetRandomValue()
+		randValue := drawSatisfying(attrs, "ValuePreds", a.ValuePreds, a.gen)
 		if randValue != nil {
 			return reflect.ValueOf(randValue)
 		}
@@ -1050,6 +1924,26 @@ func (a MapAttributes) getRandomValueValue(valueType reflect.Type) reflect.Value
 //   - AllowNil: If true, nil pointers can be generated
 //   - Depth: Number of pointer levels (1 = *T, 2 = **T, etc.)
 //   - Inner: Attributes for the pointed-to value (can be Attributes or reflect.Type)
+//   - NilProbability: Probability of a nil pointer, which requires AllowNil; when zero,
+//     AllowNil yields nil half of the time
+//   - NilInnerProbability: Probability of a non-nil pointer to the zero value of the inner type
+//     (for example a pointer to a nil slice or nil map)
+//   - EmptyInnerProbability: Probability of a non-nil pointer to an empty, non-nil slice or map;
+//     ignored for other inner types
+//   - MinDepth, MaxDepth: When MaxDepth is positive, every generated value gets its own number
+//     of pointer levels, drawn uniformly in [MinDepth, MaxDepth] (MinDepth defaults to 1), and
+//     Depth is ignored
+//
+// A random depth means the generated values no longer share one type (sometimes *T,
+// sometimes ***T), so only a parameter of type any can receive them: with MaxDepth set,
+// GetReflectType reports any, and FTAttributes.GetAttributeGivenType generates any
+// parameters from the PointerAttr instead of the JSONAttr. Such attributes can also be
+// used as the ElementAttrs of a []any slice. Typed pointer parameters such as *T, and the
+// implementations of interface parameters, are generated from the same PointerAttr with
+// Depth levels, since they cannot receive another pointer type.
+//
+// The three probabilities are mutually exclusive; whatever remains is the probability of a
+// pointer to a regularly generated inner value.
 //
 // The implementation creates proper pointer chains by allocating memory at each level
 // and setting up the chain correctly.
@@ -1071,21 +1965,34 @@ func (a MapAttributes) getRandomValueValue(valueType reflect.Type) reflect.Value
 //	    Inner: StringAttributes{MinLen: 5, MaxLen: 10},
 //	}
 //	deepPtr := deepAttrs.GetRandomValue() // Returns **string
+//
+//	// Exercise nil, pointer-to-nil and pointer-to-empty slices equally often
+//	sliceAttrs := PointerAttributes{
+//	    AllowNil: true,
+//	    Depth: 1,
+//	    Inner: SliceAttributes{MinLen: 1, MaxLen: 5, ElementAttrs: IntegerAttributesImpl[int]{}},
+//	    NilProbability: 0.25, NilInnerProbability: 0.25, EmptyInnerProbability: 0.25,
+//	}
+//
+//	// Generate *int, **int or ***int values for an any parameter
+//	chainAttrs := PointerAttributes{MinDepth: 1, MaxDepth: 3, Inner: IntegerAttributesImpl[int]{Min: 1, Max: 10}}
 type PointerAttributes struct {
-	AllowNil bool
-	Depth    int
-	Inner    any
+	AllowNil              bool
+	Depth                 int
+	Inner                 any
+	NilProbability        float64
+	NilInnerProbability   float64
+	EmptyInnerProbability float64
+	MinDepth              int
+	MaxDepth              int
 }
 
 func (a PointerAttributes) GetAttributes() any { return a }
 func (a PointerAttributes) GetReflectType() reflect.Type {
-	var inner reflect.Type
-	switch v := a.Inner.(type) {
-	case Attributes:
-		inner = v.GetReflectType()
-	case reflect.Type:
-		inner = v
+	if a.MaxDepth > 0 {
+		return anyType
 	}
+	inner := a.innerType()
 	if inner == nil {
 		return nil
 	}
@@ -1107,21 +2014,150 @@ func (a PointerAttributes) GetDefaultImplementation() Attributes {
 }
 
 func (a PointerAttributes) GetRandomValue() any {
-	if a.shouldReturnNil() {
+	if a.MaxDepth > 0 {
+		a.Depth, a.MaxDepth = a.randomDepth(), 0
+	}
+	if a.NilInnerProbability == 0 && a.EmptyInnerProbability == 0 && a.NilProbability == 0 {
+		if a.shouldReturnNil() {
+			return a.getNilPointer()
+		}
+		return a.pointTo(a.getInnerValue())
+	}
+	nilProbability := a.NilProbability
+	if !a.AllowNil {
+		nilProbability = 0
+	}
+	r := rng.Float64()
+	if r < nilProbability {
 		return a.getNilPointer()
 	}
+	r -= nilProbability
+	if r < a.NilInnerProbability {
+		return a.pointTo(a.getZeroInnerValue())
+	}
+	r -= a.NilInnerProbability
+	if r < a.EmptyInnerProbability {
+		if empty := a.getEmptyInnerValue(); empty != nil {
+			return a.pointTo(empty)
+		}
+	}
+	return a.pointTo(a.getInnerValue())
+}
 
-	innerValue := a.getInnerValue()
+// randomDepth draws a number of pointer levels in [MinDepth, MaxDepth], with MinDepth
+// raised to 1 when unset
+func (a PointerAttributes) randomDepth() int {
+	minDepth := max(a.MinDepth, 1)
+	if a.MaxDepth <= minDepth {
+		return minDepth
+	}
+	return minDepth + rng.Intn(a.MaxDepth-minDepth+1)
+}
+
+// Validate reports an InvalidAttributeError when MinDepth is greater than MaxDepth, or
+// when NilProbability is set without AllowNil, in which case GetRandomValue generates no
+// nil pointers.
+func (a PointerAttributes) Validate() error {
+	if a.MaxDepth > 0 && a.MinDepth > a.MaxDepth {
+		return InvalidAttributeError{
+			Attribute: "PointerAttributes",
+			Reason:    fmt.Sprintf("MinDepth %d is greater than MaxDepth %d", a.MinDepth, a.MaxDepth),
+		}
+	}
+	if a.NilProbability > 0 && !a.AllowNil {
+		return InvalidAttributeError{Attribute: "PointerAttributes", Reason: "NilProbability requires AllowNil"}
+	}
+	return nil
+}
+
+// pointTo wraps innerValue in the configured pointer chain, returning nil when there is no inner value
+func (a PointerAttributes) pointTo(innerValue *reflect.Value) any {
 	if innerValue == nil {
 		return nil
 	}
-
 	return a.createPointerChain(innerValue)
 }
 
 // shouldReturnNil determines if nil should be returned
 func (a PointerAttributes) shouldReturnNil() bool {
-	return a.AllowNil && rand.Intn(2) == 0
+	return a.AllowNil && rng.Intn(2) == 0
+}
+
+// getZeroInnerValue returns the zero value of the inner type (nil for slices, maps and pointers)
+func (a PointerAttributes) getZeroInnerValue() *reflect.Value {
+	innerType := a.innerType()
+	if innerType == nil {
+		return nil
+	}
+	zero := reflect.Zero(innerType)
+	return &zero
+}
+
+// getEmptyInnerValue returns an empty, non-nil slice or map of the inner type, or nil for other kinds
+func (a PointerAttributes) getEmptyInnerValue() *reflect.Value {
+	innerType := a.innerType()
+	if innerType == nil {
+		return nil
+	}
+	var empty reflect.Value
+	switch innerType.Kind() {
+	case reflect.Slice:
+		empty = reflect.MakeSlice(innerType, 0, 0)
+	case reflect.Map:
+		empty = reflect.MakeMap(innerType)
+	default:
+		return nil
+	}
+	return &empty
+}
+
+// innerType returns the type of the pointed-to value
+func (a PointerAttributes) innerType() reflect.Type {
+	switch v := a.Inner.(type) {
+	case Attributes:
+		return v.GetReflectType()
+	case reflect.Type:
+		return v
+	}
+	return nil
+}
+
+// forType adapts the attributes to a parameter of type t. Unless t is any, the random
+// depth of MinDepth and MaxDepth is dropped in favour of Depth, and an Inner
+// StructAttributes without TargetType is resolved to the struct type that pointer type t
+// points to, so that a *NamedStruct parameter receives a pointer to that named type
+// rather than to an anonymous struct built from FieldAttrs
+func (a PointerAttributes) forType(t reflect.Type) PointerAttributes {
+	if t == anyType {
+		return a
+	}
+	a = a.fixedDepth()
+	inner, ok := a.Inner.(StructAttributes)
+	if !ok || inner.TargetType != nil {
+		return a
+	}
+	elem := t
+	for i := 0; i < max(a.Depth, 1) && elem.Kind() == reflect.Pointer; i++ {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.Struct {
+		inner.TargetType = elem
+		a.Inner = inner
+	}
+	return a
+}
+
+// fixedDepth returns a copy of the attributes generating Depth pointer levels, ignoring
+// MinDepth and MaxDepth
+func (a PointerAttributes) fixedDepth() PointerAttributes {
+	a.MinDepth, a.MaxDepth = 0, 0
+	return a
+}
+
+// withGeneration returns a copy of the attributes whose inner value is generated under g
+func (a PointerAttributes) withGeneration(g generation) Attributes {
+	a.Inner = bindGeneration(a.Inner, g)
+	return a
 }
 
 // getNilPointer returns a nil pointer of the correct type
@@ -1167,13 +2203,39 @@ func (a PointerAttributes) createPointerChain(innerValue *reflect.Value) any {
 //
 // Fields:
 //   - FieldAttrs: A map from field name to field attributes (can be Attributes or reflect.Type)
-//
-// The implementation uses reflection to dynamically create struct types at runtime
-// based on the field configurations. Each field is populated with a random value
-// generated by its corresponding attribute.
-//
-// Note: The generated struct type is created dynamically using reflect.StructOf,
+//   - TargetType: Optional concrete struct type to generate; fields named in FieldAttrs are
+//     set on a value of this type and every other field is left at its zero value
+//   - FillUnlisted: With TargetType, fills every exported field missing from FieldAttrs
+//     using the default attributes for its type, descending into nested structs, slices,
+//     maps and pointers up to three levels deep
+//   - Relation: Optional cross-field constraint, such as End >= Start, over the exported
+//     fields of the generated struct keyed by name; structs violating it are repaired or
+//     resampled
+//   - Repair: Optional function called with the fields of a struct violating Relation; it
+//     may edit the map in place, for example swapping Start and End, and the edited values
+//     are written back to the struct
+//   - ResampleFields: The fields redrawn when Relation still fails; empty redraws every
+//     generated field
+//
+// With a Relation, a struct violating it is first handed to Repair, if set, then has
+// ResampleFields redrawn (and repaired again) until Relation holds, up to the retry limit
+// of GenerationConfig.MaxRetries, after which GetRandomValue returns the zero struct and
+// GenerateValue fails with a ConstraintUnsatisfiableError. This yields internally
+// consistent structs on every iteration instead of discarding most of them with
+// preconditions.
+//
+// Without TargetType the implementation uses reflection to dynamically create struct
+// types at runtime based on the field configurations. Each field is populated with a
+// random value generated by its corresponding attribute.
+//
+// Note: The dynamically generated struct type is created using reflect.StructOf,
 // so it won't have any methods or struct tags beyond what's defined in FieldAttrs.
+// Set TargetType to generate values of a real struct type instead. Unexported fields
+// of TargetType cannot be set and are always left at their zero value. Values of
+// TargetType keep its method set, so when TargetType implements an interface,
+// FTAttributes.GetAttributeGivenType uses the StructAttr to generate parameters of that
+// interface type; for methods with a pointer receiver, configure a PointerAttr whose
+// Inner is the StructAttributes instead.
 //
 // Example usage:
 //
@@ -1185,17 +2247,44 @@ func (a PointerAttributes) createPointerChain(innerValue *reflect.Value) any {
 //	    },
 //	}
 //	randomStruct := attrs.GetRandomValue() // Returns a struct with ID and Name fields
+//
+//	// Generate Person values with a constrained Age and every other field defaulted
+//	personAttrs := StructAttributes{
+//	    TargetType:   reflect.TypeOf(Person{}),
+//	    FieldAttrs:   map[string]any{"Age": IntegerAttributesImpl[int]{Min: 0, Max: 120}},
+//	    FillUnlisted: true,
+//	}
+//
+//	// Generate TimeRange values whose End is never before Start
+//	rangeAttrs := StructAttributes{
+//	    TargetType:     reflect.TypeOf(TimeRange{}),
+//	    FieldAttrs:     map[string]any{"Start": IntegerAttributesImpl[int]{Min: 0, Max: 100}, "End": IntegerAttributesImpl[int]{Min: 0, Max: 100}},
+//	    Relation:       func(fields map[string]any) bool { return fields["End"].(int) >= fields["Start"].(int) },
+//	    ResampleFields: []string{"End"},
+//	}
 type StructAttributes struct {
-	FieldAttrs map[string]any
+	FieldAttrs     map[string]any
+	TargetType     reflect.Type
+	FillUnlisted   bool
+	Relation       func(fields map[string]any) bool
+	Repair         func(fields map[string]any)
+	ResampleFields []string
+
+	depth int
+	gen   generation
 }
 
 func (a StructAttributes) GetAttributes() any { return a }
 func (a StructAttributes) GetReflectType() reflect.Type {
+	if a.TargetType != nil {
+		return a.TargetType
+	}
 	if len(a.FieldAttrs) == 0 {
 		return nil
 	}
 	fields := make([]reflect.StructField, 0, len(a.FieldAttrs))
-	for name, attr := range a.FieldAttrs {
+	for _, name := range a.fieldNames() {
+		attr := a.FieldAttrs[name]
 		var ft reflect.Type
 		switch v := attr.(type) {
 		case Attributes:
@@ -1233,10 +2322,142 @@ func (a StructAttributes) GetRandomValue() any {
 		return nil
 	}
 	structValue := a.createStructValue(structType)
+	if a.gen.tooDeep() {
+		return structValue.Interface()
+	}
 	a.populateStructFields(structValue)
+	if a.TargetType != nil && a.FillUnlisted {
+		a.populateUnlistedFields(structValue)
+	}
+	if a.Relation != nil && !a.enforceRelation(structValue) {
+		return reflect.Zero(structType).Interface()
+	}
 	return structValue.Interface()
 }
 
+// Validate reports an InvalidAttributeError when TargetType is not a struct type, Repair
+// or ResampleFields is set without a Relation, or ResampleFields names a field that is
+// not generated.
+func (a StructAttributes) Validate() error {
+	invalid := func(reason string) error {
+		return InvalidAttributeError{Attribute: "StructAttributes", Reason: reason}
+	}
+	if a.TargetType != nil && a.TargetType.Kind() != reflect.Struct {
+		return invalid("TargetType must be a struct type")
+	}
+	if a.Relation == nil && (a.Repair != nil || len(a.ResampleFields) > 0) {
+		return invalid("Repair and ResampleFields require a Relation")
+	}
+	for _, name := range a.ResampleFields {
+		if _, listed := a.FieldAttrs[name]; listed {
+			continue
+		}
+		if a.TargetType == nil || !a.FillUnlisted {
+			return invalid(fmt.Sprintf("resampled field %q is not in FieldAttrs", name))
+		}
+		if field, ok := a.TargetType.FieldByName(name); !ok || !field.IsExported() || len(field.Index) != 1 {
+			return invalid(fmt.Sprintf("resampled field %q is not an exported field of %v", name, a.TargetType))
+		}
+	}
+	return nil
+}
+
+// enforceRelation repairs and resamples the fields of structValue until Relation holds.
+// It reports false, recording a ConstraintUnsatisfiableError, once the retry budget is
+// spent.
+func (a StructAttributes) enforceRelation(structValue reflect.Value) bool {
+	attempt := 0
+	_, ok := a.gen.generateUntil(
+		func() string { return "StructAttributes Relation" },
+		func(v any) bool { return a.Relation(v.(map[string]any)) },
+		func() any {
+			if attempt++; attempt > 1 {
+				a.resample(structValue)
+			}
+			fields := structFields(structValue)
+			if a.Repair != nil && !a.Relation(fields) {
+				a.Repair(fields)
+				a.setFields(structValue, fields)
+				fields = structFields(structValue)
+			}
+			return fields
+		},
+	)
+	return ok
+}
+
+// resample redraws ResampleFields, or every generated field when it is empty.
+func (a StructAttributes) resample(structValue reflect.Value) {
+	if len(a.ResampleFields) == 0 {
+		a.populateStructFields(structValue)
+		if a.TargetType != nil && a.FillUnlisted {
+			a.populateUnlistedFields(structValue)
+		}
+		return
+	}
+	for _, name := range a.ResampleFields {
+		field := structValue.FieldByName(name)
+		if !a.isFieldSettable(field) {
+			continue
+		}
+		if fieldAttr, listed := a.FieldAttrs[name]; listed {
+			a.setFieldValue(field, a.generateFieldValue(fieldAttr, field.Type()))
+		} else if a.TargetType != nil && a.FillUnlisted {
+			field.Set(a.unlistedFieldValue(field.Type()))
+		}
+	}
+}
+
+// structFields returns the exported fields of structValue keyed by name.
+func structFields(structValue reflect.Value) map[string]any {
+	fields := make(map[string]any, structValue.NumField())
+	for i := 0; i < structValue.NumField(); i++ {
+		if structValue.Type().Field(i).IsExported() {
+			fields[structValue.Type().Field(i).Name] = structValue.Field(i).Interface()
+		}
+	}
+	return fields
+}
+
+// setFields writes the values of fields back to the matching fields of structValue; a nil
+// value resets a field to its zero value.
+func (a StructAttributes) setFields(structValue reflect.Value, fields map[string]any) {
+	for name, value := range fields {
+		field := structValue.FieldByName(name)
+		if !a.isFieldSettable(field) {
+			continue
+		}
+		if value == nil {
+			field.Set(reflect.Zero(field.Type()))
+			continue
+		}
+		a.setFieldValue(field, reflect.ValueOf(value))
+	}
+}
+
+// populateUnlistedFields fills the exported fields missing from FieldAttrs with values
+// from the default attributes for their type
+func (a StructAttributes) populateUnlistedFields(structValue reflect.Value) {
+	for i := 0; i < structValue.NumField(); i++ {
+		fieldInfo := a.TargetType.Field(i)
+		if _, listed := a.FieldAttrs[fieldInfo.Name]; listed || !fieldInfo.IsExported() {
+			continue
+		}
+		field := structValue.Field(i)
+		if !a.isFieldSettable(field) {
+			continue
+		}
+		field.Set(a.unlistedFieldValue(fieldInfo.Type))
+	}
+}
+
+// unlistedFieldValue generates a value for a field of type t missing from FieldAttrs
+// using the default attributes for its type
+func (a StructAttributes) unlistedFieldValue(t reflect.Type) reflect.Value {
+	attrs, _ := bindGeneration(attributesForType(t, a.depth+1), a.gen.nested()).(Attributes)
+	return randomValueOf(attrs, t)
+}
+
 // createStructValue creates a new struct value of the given type
 func (a StructAttributes) createStructValue(structType reflect.Type) reflect.Value {
 	return reflect.New(structType).Elem()
@@ -1244,15 +2465,27 @@ func (a StructAttributes) createStructValue(structType reflect.Type) reflect.Val
 
 // populateStructFields populates all struct fields with random values
 func (a StructAttributes) populateStructFields(structValue reflect.Value) {
-	for fieldName, fieldAttr := range a.FieldAttrs {
+	for _, fieldName := range a.fieldNames() {
 		field := structValue.FieldByName(fieldName)
 		if a.isFieldSettable(field) {
-			fieldValue := a.generateFieldValue(fieldAttr, field.Type())
+			fieldValue := a.generateFieldValue(a.FieldAttrs[fieldName], field.Type())
 			a.setFieldValue(field, fieldValue)
 		}
 	}
 }
 
+// fieldNames returns the names in FieldAttrs, sorted when the generation is deterministic.
+func (a StructAttributes) fieldNames() []string {
+	names := make([]string, 0, len(a.FieldAttrs))
+	for name := range a.FieldAttrs {
+		names = append(names, name)
+	}
+	if a.gen.deterministic {
+		sort.Strings(names)
+	}
+	return names
+}
+
 // isFieldSettable checks if the field is valid and can be set
 func (a StructAttributes) isFieldSettable(field reflect.Value) bool {
 	return field.IsValid() && field.CanSet()
@@ -1269,6 +2502,20 @@ func (a StructAttributes) generateFieldValue(fieldAttr any, fieldType reflect.Ty
 	return reflect.Zero(fieldType)
 }
 
+// withGeneration returns a copy of the attributes whose fields are generated one
+// nesting level below g
+func (a StructAttributes) withGeneration(g generation) Attributes {
+	if !g.tooDeep() {
+		fieldAttrs := make(map[string]any, len(a.FieldAttrs))
+		for name, attr := range a.FieldAttrs {
+			fieldAttrs[name] = bindGeneration(attr, g.nested())
+		}
+		a.FieldAttrs = fieldAttrs
+	}
+	a.gen = g
+	return a
+}
+
 // setFieldValue sets the field value with proper type conversion if needed
 func (a StructAttributes) setFieldValue(field, fieldValue reflect.Value) {
 	if fieldValue.Type().AssignableTo(field.Type()) {
@@ -1279,6 +2526,12 @@ func (a StructAttributes) setFieldValue(field, fieldValue reflect.Value) {
 }
 
 func (a StructAttributes) getStructReflectType() (reflect.Type, error) {
+	if a.TargetType != nil {
+		if a.TargetType.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("target type is not a struct")
+		}
+		return a.TargetType, nil
+	}
 	if len(a.FieldAttrs) == 0 {
 		return nil, fmt.Errorf("no field attributes found")
 	}
@@ -1312,6 +2565,8 @@ type ArrayAttributes struct {
 	Length       int
 	Sorted       bool
 	ElementAttrs any
+
+	gen generation
 }
 
 func (a ArrayAttributes) GetAttributes() any { return a }
@@ -1340,7 +2595,8 @@ func (a ArrayAttributes) GetDefaultImplementation() Attributes {
 }
 
 func (a ArrayAttributes) GetRandomValue() any {
-	if !a.isValidLength() {
+	// An array too long to generate is not allocated as a zero array either
+	if !a.isValidLength() || !a.gen.checkLength("array length", a.Length) {
 		return nil
 	}
 
@@ -1350,7 +2606,9 @@ func (a ArrayAttributes) GetRandomValue() any {
 	}
 
 	arrayValue := a.createArrayValue(elemType)
-	a.populateArrayElements(arrayValue, elemType)
+	if !a.gen.tooDeep() {
+		a.populateArrayElements(arrayValue, elemType)
+	}
 	return arrayValue.Interface()
 }
 
@@ -1361,8 +2619,11 @@ func (a ArrayAttributes) isValidLength() bool {
 
 // getElementType returns the element type for the array
 func (a ArrayAttributes) getElementType() reflect.Type {
-	if attrs, ok := a.ElementAttrs.(Attributes); ok {
-		return attrs.GetReflectType()
+	switch v := a.ElementAttrs.(type) {
+	case Attributes:
+		return v.GetReflectType()
+	case reflect.Type:
+		return v
 	}
 	return nil
 }
@@ -1373,21 +2634,240 @@ func (a ArrayAttributes) createArrayValue(elemType reflect.Type) reflect.Value {
 	return reflect.New(arrayType).Elem()
 }
 
-// populateArrayElements fills the array with random elements
+// populateArrayElements fills the array with random elements, leaving the remaining
+// elements at their zero value once the element budget runs out
 func (a ArrayAttributes) populateArrayElements(arrayValue reflect.Value, elemType reflect.Type) {
-	for i := 0; i < a.Length; i++ {
-		elemValue := a.generateElementValue(elemType)
+	attrs := resolveAttributes(a.ElementAttrs)
+	for i := 0; i < a.Length && !a.gen.budget.exhausted(); i++ {
+		elemValue := a.generateElementValue(attrs, elemType)
 		arrayValue.Index(i).Set(elemValue)
 	}
 }
 
+// withGeneration returns a copy of the attributes whose elements are generated one
+// nesting level below g
+func (a ArrayAttributes) withGeneration(g generation) Attributes {
+	if !g.tooDeep() {
+		a.ElementAttrs = bindGeneration(a.ElementAttrs, g.nested())
+	}
+	a.gen = g
+	return a
+}
+
 // generateElementValue generates a random value for an array element
-func (a ArrayAttributes) generateElementValue(elemType reflect.Type) reflect.Value {
-	if attrs, ok := a.ElementAttrs.(Attributes); ok {
-		randVal := attrs.GetRandomValue()
-		if randVal != nil {
-			return reflect.ValueOf(randVal)
+func (a ArrayAttributes) generateElementValue(attrs Attributes, elemType reflect.Type) reflect.Value {
+	return randomValueOf(attrs, elemType)
+}
+
+// anyType is the reflect.Type of the empty interface, which JSONAttributes generates values for.
+var anyType = reflect.TypeOf((*any)(nil)).Elem()
+
+// JSONAttributes configures the generation of JSON-like values for parameters of type
+// any: trees of nil, bool, float64, string, []any and map[string]any, the same shapes
+// encoding/json decodes into an any.
+//
+// Fields:
+//   - MaxDepth: The maximum nesting depth of arrays and objects (defaults to 3); values at
+//     this depth are always scalars
+//   - MaxBreadth: The maximum number of elements of an array or entries of an object
+//     (defaults to 5)
+//
+// FTAttributes uses JSONAttributes for parameters whose type is exactly any, unless its
+// PointerAttr has a random depth (see PointerAttributes.MaxDepth).
+//
+// Example usage:
+//
+//	attrs := JSONAttributes{MaxDepth: 2, MaxBreadth: 3}
+//	doc := attrs.GetRandomValue() // e.g. map[string]any{"k3x": []any{1.5, nil}, "a": true}
+type JSONAttributes struct {
+	MaxDepth   int
+	MaxBreadth int
+
+	gen generation
+}
+
+func (a JSONAttributes) GetAttributes() any           { return a }
+func (a JSONAttributes) GetReflectType() reflect.Type { return anyType }
+func (a JSONAttributes) GetDefaultImplementation() Attributes {
+	return JSONAttributes{MaxDepth: 3, MaxBreadth: 5}
+}
+
+func (a JSONAttributes) GetRandomValue() any {
+	return a.generateJSONValue(0)
+}
+
+// withGeneration returns a copy of the attributes generating scalars under g
+func (a JSONAttributes) withGeneration(g generation) Attributes {
+	a.gen = g
+	return a
+}
+
+// getJSONBounds returns the configured depth and breadth, applying the defaults
+func (a JSONAttributes) getJSONBounds() (int, int) {
+	maxDepth, maxBreadth := a.MaxDepth, a.MaxBreadth
+	if maxDepth <= 0 {
+		maxDepth = 3
+	}
+	if maxBreadth <= 0 {
+		maxBreadth = 5
+	}
+	return maxDepth, maxBreadth
+}
+
+// generateJSONValue generates a scalar, array or object; only scalars below maxDepth
+func (a JSONAttributes) generateJSONValue(depth int) any {
+	maxDepth, maxBreadth := a.getJSONBounds()
+	kinds := 4
+	if depth < maxDepth {
+		kinds = 6
+	}
+	switch rng.Intn(kinds) {
+	case 4:
+		arr := make([]any, 0, maxBreadth)
+		for n := rng.Intn(maxBreadth + 1); n > 0 && !a.gen.budget.exhausted(); n-- {
+			arr = append(arr, a.generateJSONValue(depth+1))
 		}
+		return arr
+	case 5:
+		keys := StringAttributes{MinLen: 1, MaxLen: 8}
+		obj := make(map[string]any, maxBreadth)
+		for n := rng.Intn(maxBreadth + 1); n > 0 && !a.gen.budget.exhausted(); n-- {
+			obj[keys.GetRandomValue().(string)] = a.generateJSONValue(depth + 1)
+		}
+		return obj
 	}
-	return reflect.Zero(elemType)
+	if !a.gen.budget.take() {
+		return nil
+	}
+	switch rng.Intn(4) {
+	case 0:
+		return nil
+	case 1:
+		return rng.Intn(2) == 0
+	case 2:
+		return FloatAttributesImpl[float64]{}.GetDefaultImplementation().GetRandomValue()
+	default:
+		return StringAttributes{}.GetDefaultImplementation().GetRandomValue()
+	}
+}
+
+// PredicateConstrainedAttributes generates values from Base that satisfy every predicate
+// in Preds, which lets a constraint written once as predicates drive both generation and
+// validation.
+//
+// Fields:
+//   - Base: The attributes values are drawn from
+//   - Preds: Predicates that every generated value must satisfy
+//
+// Values failing any predicate are redrawn, up to the retry budget of GenerationConfig.
+// When the budget runs out GetRandomValue returns the zero value of the type of Base and
+// GenerateValue fails with a ConstraintUnsatisfiableError, so Base should produce
+// satisfying values reasonably often.
+//
+// Example usage:
+//
+//	// Generate even ints in [0, 100]
+//	attrs := PredicateConstrainedAttributes{
+//	    Base:  IntegerAttributesImpl[int]{Min: 0, Max: 100},
+//	    Preds: []p.Predicate{evenPredicate},
+//	}
+//	randomEven := attrs.GetRandomValue()
+type PredicateConstrainedAttributes struct {
+	Base  Attributes
+	Preds []p.Predicate
+
+	gen    generation
+	budget *elementBudget
+}
+
+func (a PredicateConstrainedAttributes) GetAttributes() any { return a }
+func (a PredicateConstrainedAttributes) GetReflectType() reflect.Type {
+	if a.Base == nil {
+		return nil
+	}
+	return a.Base.GetReflectType()
+}
+
+func (a PredicateConstrainedAttributes) GetDefaultImplementation() Attributes {
+	if a.Base == nil {
+		return a
+	}
+	a.Base = a.Base.GetDefaultImplementation()
+	return a
+}
+
+func (a PredicateConstrainedAttributes) GetRandomValue() any {
+	if a.Base == nil || !a.budget.take() {
+		return nil
+	}
+	if v := drawSatisfying(a.Base, "Preds", a.Preds, a.gen); v != nil {
+		return v
+	}
+	if t := a.Base.GetReflectType(); t != nil {
+		return reflect.Zero(t).Interface()
+	}
+	return nil
+}
+
+// Validate reports an InvalidAttributeError when no Base attributes are configured.
+func (a PredicateConstrainedAttributes) Validate() error {
+	if a.Base == nil {
+		return InvalidAttributeError{Attribute: "PredicateConstrainedAttributes", Reason: "Base is required"}
+	}
+	return nil
+}
+
+// withGeneration returns a copy of the attributes whose base values are generated under g.
+// A leaf base is counted once per accepted value rather than once per draw, so that
+// rejected draws do not spend the element budget.
+func (a PredicateConstrainedAttributes) withGeneration(g generation) Attributes {
+	if _, ok := a.Base.(compositeAttributes); !ok {
+		a.budget, g.budget = g.budget, nil
+	}
+	if base, ok := bindGeneration(a.Base, g).(Attributes); ok {
+		a.Base = base
+	}
+	a.gen = g
+	return a
+}
+
+// resolveAttributes returns spec when it is an Attributes implementation, or the default
+// attributes for its kind when spec is a reflect.Type. It returns nil for anything else,
+// including kinds without a default implementation.
+func resolveAttributes(spec any) Attributes {
+	switch v := spec.(type) {
+	case Attributes:
+		return v
+	case reflect.Type:
+		if v == nil {
+			return nil
+		}
+		attrs, err := FTAttributes{}.getDefaultForKind(v.Kind())
+		if err != nil {
+			return nil
+		}
+		return attrs
+	}
+	return nil
+}
+
+// randomValueOf generates a value with attrs and converts it to t when its type differs
+// (for example an int from default attributes for an int8 element). It returns the zero
+// value of t when attrs is nil, produces nil, or produces a value not convertible to t.
+func randomValueOf(attrs Attributes, t reflect.Type) reflect.Value {
+	if attrs == nil {
+		return reflect.Zero(t)
+	}
+	randVal := attrs.GetRandomValue()
+	if randVal == nil {
+		return reflect.Zero(t)
+	}
+	v := reflect.ValueOf(randVal)
+	if v.Type() == t {
+		return v
+	}
+	if v.Type().ConvertibleTo(t) {
+		return v.Convert(t)
+	}
+	return reflect.Zero(t)
 }
@@ -60,3 +60,92 @@ type NilTypeError struct{}
 func (nte NilTypeError) Error() string {
 	return "provided type is null"
 }
+
+// InvalidAttributeError is returned by Validate when an attribute's configuration
+// is inconsistent and cannot be used to generate values.
+//
+// Fields:
+//   - Attribute: The name of the attribute type that was misconfigured
+//   - Reason: A description of the violated constraint
+//
+// Example scenario:
+//
+//	attrs := FloatAttributesImpl[float64]{Min: 0, Max: 1, LogScale: true}
+//	err := attrs.Validate()
+//	// Returns InvalidAttributeError{Attribute: "FloatAttributes", Reason: "LogScale requires Min > 0"}
+type InvalidAttributeError struct {
+	Attribute string
+	Reason    string
+}
+
+func (iae InvalidAttributeError) Error() string {
+	return fmt.Sprintf("invalid %s configuration: %s", iae.Attribute, iae.Reason)
+}
+
+// ConstraintUnsatisfiableError is returned by GenerateValue when a constrained generator
+// (ElementPreds, KeyPreds, ValuePreds, UniqueChars or NonZero floats) rejected every draw
+// within its retry budget, which usually means the constraint cannot be met by the
+// configured attributes. GetRandomValue falls back to the zero value instead.
+//
+// Fields:
+//   - Constraint: A description of the constraint that could not be satisfied
+//   - Attempts: The number of draws that were made
+//
+// Example scenario:
+//
+//	attrs := SliceAttributes{ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 10}, ElementPreds: []p.Predicate{negative}}
+//	_, err := GenerateValue(attrs)
+//	// Returns ConstraintUnsatisfiableError{Constraint: "ElementPreds [...]", Attempts: 100}
+type ConstraintUnsatisfiableError struct {
+	Constraint string
+	Attempts   int
+}
+
+func (cue ConstraintUnsatisfiableError) Error() string {
+	return fmt.Sprintf("could not satisfy constraint %s after %d attempts", cue.Constraint, cue.Attempts)
+}
+
+// TypeMismatchError is returned by RandomValue when the attributes registered for a type
+// generate values that cannot be converted to it, or only with a loss, such as a float
+// for an integer type or an int64 too large for an int8.
+//
+// Fields:
+//   - Want: The requested type
+//   - Got: The type of the generated value
+//
+// Example scenario:
+//
+//	_, err := RandomValue(reflect.TypeFor[time.Time](), NewFTAttributes())
+//	// Returns TypeMismatchError{Want: time.Time, Got: struct {...}} unless time.Time is registered
+type TypeMismatchError struct {
+	Want reflect.Type
+	Got  reflect.Type
+}
+
+func (tme TypeMismatchError) Error() string {
+	return fmt.Sprintf("attributes for %v generate values of type %v", tme.Want, tme.Got)
+}
+
+// CollectionTooLargeError is returned by GenerateValue when a slice, array or map is
+// configured with a length beyond GenerationConfig.MaxCollectionLength, so that a typo
+// in MaxLen cannot turn a quick test into an out-of-memory failure.
+//
+// Fields:
+//   - Collection: The configured quantity, such as "slice length" or "map size"
+//   - Length: The configured length
+//   - Max: The MaxCollectionLength it exceeds
+//
+// Example scenario:
+//
+//	attrs := FTAttributes{SliceAttr: SliceAttributes{MaxLen: 5000000}, Generation: GenerationConfig{MaxCollectionLength: 1000000}}
+//	// Generating a []int returns
+//	// CollectionTooLargeError{Collection: "slice length", Length: 5000000, Max: 1000000}
+type CollectionTooLargeError struct {
+	Collection string
+	Length     int
+	Max        int
+}
+
+func (ctle CollectionTooLargeError) Error() string {
+	return fmt.Sprintf("requested %s %d exceeds MaxCollectionLength %d", ctle.Collection, ctle.Length, ctle.Max)
+}
@@ -1,9 +1,12 @@
 package attributes
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+
+	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
 )
 
 func TestGetAttributeGivenTypeNil(t *testing.T) {
@@ -138,3 +141,546 @@ func TestNilTypeError_Error(t *testing.T) {
 		t.Errorf("unexpected error message: got %q, want %q", err.Error(), expected)
 	}
 }
+
+func TestInvalidAttributeError_Error(t *testing.T) {
+	err := InvalidAttributeError{Attribute: "FloatAttributes", Reason: "LogScale requires Min > 0"}
+	expected := "invalid FloatAttributes configuration: LogScale requires Min > 0"
+	if err.Error() != expected {
+		t.Errorf("unexpected error message: got %q, want %q", err.Error(), expected)
+	}
+}
+
+func TestGetAttributeGivenType_Validates(t *testing.T) {
+	attrs := FTAttributes{FloatAttr: FloatAttributesImpl[float64]{Min: -1, Max: 1, LogScale: true}}
+	got, err := attrs.GetAttributeGivenType(reflect.TypeOf(float64(0)))
+	if _, ok := err.(InvalidAttributeError); !ok {
+		t.Fatalf("expected InvalidAttributeError, got %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected nil attribute on validation failure, got %v", got)
+	}
+}
+
+func TestFTAttributes_Merge(t *testing.T) {
+	base := NewFTAttributes()
+	override := FTAttributes{
+		StringAttr:  StringAttributes{MinLen: 5, MaxLen: 8},
+		IntegerAttr: IntegerAttributesImpl[int]{Min: 1, Max: 2},
+	}
+	merged := base.Merge(override)
+	if !reflect.DeepEqual(merged.StringAttr, override.StringAttr) || !reflect.DeepEqual(merged.IntegerAttr, override.IntegerAttr) {
+		t.Errorf("expected overridden fields to be taken from override, got %+v", merged)
+	}
+	if !reflect.DeepEqual(merged.FloatAttr, base.FloatAttr) || !reflect.DeepEqual(merged.MapAttr, base.MapAttr) {
+		t.Error("expected zero fields in override to keep the base configuration")
+	}
+	if !reflect.DeepEqual(base, NewFTAttributes()) {
+		t.Error("expected Merge not to modify the base")
+	}
+	if !reflect.DeepEqual(base.Merge(FTAttributes{}), base) {
+		t.Error("expected merging an empty override to be a no-op")
+	}
+}
+
+func TestFTAttributes_With(t *testing.T) {
+	intAttr := IntegerAttributesImpl[int]{Min: 0, Max: 10}
+	strAttr := StringAttributes{MinLen: 3, MaxLen: 3}
+	attrs := NewFTAttributes().With(reflect.Int64, intAttr).With(reflect.String, strAttr)
+	if !reflect.DeepEqual(attrs.IntegerAttr, intAttr) || !reflect.DeepEqual(attrs.StringAttr, strAttr) {
+		t.Errorf("expected With to replace the configured attributes, got %+v", attrs)
+	}
+	got, err := attrs.GetAttributeGivenType(reflect.TypeOf(""))
+	if err != nil || !reflect.DeepEqual(got, strAttr) {
+		t.Errorf("expected the string attribute to be used, got %v (err %v)", got, err)
+	}
+	base := NewFTAttributes()
+	if !reflect.DeepEqual(base.With(reflect.Chan, intAttr), base) {
+		t.Error("expected unsupported kinds to leave the attributes unchanged")
+	}
+	if !reflect.DeepEqual(base.With(reflect.String, intAttr), base) {
+		t.Error("expected mismatched attribute types to leave the attributes unchanged")
+	}
+	if !reflect.DeepEqual(base.With(reflect.Int, nil), base) {
+		t.Error("expected a nil attribute to leave the attributes unchanged")
+	}
+}
+
+func TestFTAttributes_KindAttributes(t *testing.T) {
+	attrs := NewFTAttributes()
+	for kind, name := range kindFields {
+		field := reflect.ValueOf(attrs).FieldByName(name)
+		if !field.IsValid() {
+			t.Fatalf("kindFields maps %v to the unknown field %s", kind, name)
+		}
+		if got := attrs.kindAttributes(kind); !reflect.DeepEqual(got, field.Interface()) {
+			t.Errorf("expected the %s field for %v, got %v", name, kind, got)
+		}
+	}
+	if attrs.kindAttributes(reflect.Chan) != nil {
+		t.Error("expected no attributes for an unsupported kind")
+	}
+}
+
+func TestGetAttributeGivenType_MaxGeneratedElements(t *testing.T) {
+	attrs := FTAttributes{
+		SliceAttr: SliceAttributes{
+			MinLen:       10,
+			MaxLen:       10,
+			ElementAttrs: SliceAttributes{MinLen: 10, MaxLen: 10, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 9}},
+		},
+		ArrayAttr:            ArrayAttributes{Length: 8, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 9}},
+		MaxGeneratedElements: 25,
+	}
+	sliceAttr, err := attrs.GetAttributeGivenType(reflect.TypeOf([][]int{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 20 {
+		total := 0
+		for _, inner := range sliceAttr.GetRandomValue().([][]int) {
+			total += len(inner)
+		}
+		if total != 25 {
+			t.Fatalf("expected nested slices truncated to 25 leaf values, got %d", total)
+		}
+	}
+	attrs.MaxGeneratedElements = 3
+	arrayAttr, err := attrs.GetAttributeGivenType(reflect.TypeOf([8]int{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	arr := arrayAttr.GetRandomValue().([8]int)
+	for i, v := range arr {
+		if (i < 3) != (v != 0) {
+			t.Fatalf("expected only the first 3 array elements to be generated, got %v", arr)
+		}
+	}
+	if arrayAttr.GetReflectType() != reflect.TypeOf([8]int{}) {
+		t.Errorf("expected the budgeted attributes to keep the reflect type, got %v", arrayAttr.GetReflectType())
+	}
+}
+
+func TestGetAttributeGivenType_MaxGeneratedElementsPerValue(t *testing.T) {
+	attrs := FTAttributes{
+		MapAttr:              MapAttributes{MinSize: 5, MaxSize: 5, KeyAttrs: IntegerAttributesImpl[int]{Min: 0, Max: 1 << 40}, ValueAttrs: BoolAttributes{}},
+		MaxGeneratedElements: 4,
+	}
+	mapAttr, err := attrs.GetAttributeGivenType(reflect.TypeOf(map[int]bool{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 10 {
+		if got := len(mapAttr.GetRandomValue().(map[int]bool)); got != 2 {
+			t.Fatalf("expected each map to get a fresh budget of 2 entries, got %d", got)
+		}
+	}
+	attrs.MaxGeneratedElements = 0
+	unbounded, _ := attrs.GetAttributeGivenType(reflect.TypeOf(map[int]bool{}))
+	if !reflect.DeepEqual(unbounded, attrs.MapAttr) {
+		t.Errorf("expected no wrapping without a budget, got %T", unbounded)
+	}
+}
+
+func TestGenerateValueReport_Truncated(t *testing.T) {
+	attrs := FTAttributes{
+		SliceAttr:            SliceAttributes{MinLen: 10, MaxLen: 10, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 9}},
+		MaxGeneratedElements: 5,
+	}
+	sliceAttr, _ := attrs.GetAttributeGivenType(reflect.TypeOf([]int{}))
+	v, report, err := GenerateValueReport(sliceAttr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !report.Truncated || len(v.([]int)) != 5 {
+		t.Errorf("expected a slice truncated to 5 elements to be reported, got %v (%+v)", v, report)
+	}
+	attrs.MaxGeneratedElements = 10
+	sliceAttr, _ = attrs.GetAttributeGivenType(reflect.TypeOf([]int{}))
+	if _, report, _ := GenerateValueReport(sliceAttr); report.Truncated {
+		t.Errorf("expected a slice within the budget not to be reported as truncated")
+	}
+}
+
+func TestConstraintUnsatisfiableError_Error(t *testing.T) {
+	err := ConstraintUnsatisfiableError{Constraint: "UniqueChars", Attempts: 100}
+	expected := "could not satisfy constraint UniqueChars after 100 attempts"
+	if err.Error() != expected {
+		t.Errorf("unexpected error message: got %q, want %q", err.Error(), expected)
+	}
+}
+
+func TestGenerateValue_ConstraintUnsatisfiable(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 1, MaxLen: 1, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 9}, ElementPreds: []p.Predicate{neverSatisfied{}}}
+	v, err := GenerateValue(attrs)
+	cue, ok := err.(ConstraintUnsatisfiableError)
+	if !ok || v != nil {
+		t.Fatalf("expected ConstraintUnsatisfiableError and no value, got %v (err %v)", v, err)
+	}
+	if cue.Constraint != "ElementPreds [never]" || cue.Attempts != defaultMaxRetries {
+		t.Errorf("unexpected error details: %+v", cue)
+	}
+	v, err = GenerateValue(IntegerAttributesImpl[int]{Min: 1, Max: 9})
+	if err != nil || v == nil {
+		t.Errorf("expected unconstrained generation to succeed, got %v (err %v)", v, err)
+	}
+}
+
+func TestGetRandomValue_ConstraintUnsatisfiableFallsBackToZero(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		attrs Attributes
+		want  any
+	}{
+		{"ElementPreds", SliceAttributes{MinLen: 2, MaxLen: 2, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 9}, ElementPreds: []p.Predicate{neverSatisfied{}}}, []int{0, 0}},
+		{"UniqueChars", StringAttributes{MinLen: 3, MaxLen: 3, AllowedRunes: []rune("ab"), UniqueChars: true}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.attrs.GetRandomValue(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected the zero value %#v, got %#v", tc.want, got)
+			}
+			if _, err := GenerateValue(tc.attrs); !errors.As(err, new(ConstraintUnsatisfiableError)) {
+				t.Errorf("expected GenerateValue to report ConstraintUnsatisfiableError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRandomValue(t *testing.T) {
+	type celsius float64
+	v, err := RandomValue(reflect.TypeFor[celsius](), NewFTAttributes())
+	if _, ok := v.(celsius); err != nil || !ok {
+		t.Errorf("expected a celsius value, got %v (%T, err %v)", v, v, err)
+	}
+	v, err = RandomValue(reflect.TypeFor[int8](), FTAttributes{IntegerAttr: IntegerAttributesImpl[int]{Min: 3, Max: 3}})
+	if err != nil || v != int8(3) {
+		t.Errorf("expected the int converted to int8, got %v (%T, err %v)", v, v, err)
+	}
+	v, err = RandomValue(reflect.TypeFor[*int](), FTAttributes{PointerAttr: PointerAttributes{AllowNil: true, NilProbability: 1, Inner: IntegerAttributesImpl[int]{Min: 1, Max: 9}}})
+	if ptr, ok := v.(*int); err != nil || !ok || ptr != nil {
+		t.Errorf("expected a typed nil pointer, got %v (%T, err %v)", v, v, err)
+	}
+	var tme TypeMismatchError
+	_, err = RandomValue(reflect.TypeFor[[]string](), FTAttributes{SliceAttr: SliceAttributes{MinLen: 1, MaxLen: 1, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 9}}})
+	if !errors.As(err, &tme) || tme.Want != reflect.TypeFor[[]string]() || tme.Got != reflect.TypeFor[[]int]() {
+		t.Errorf("expected TypeMismatchError, got %v", err)
+	}
+	_, err = RandomValue(reflect.TypeFor[int](), FTAttributes{TypeAttrs: map[reflect.Type]Attributes{reflect.TypeFor[int](): FloatAttributesImpl[float64]{Min: 1.5, Max: 2.5}}})
+	if !errors.As(err, &tme) || tme.Got != reflect.TypeFor[float64]() {
+		t.Errorf("expected TypeMismatchError for a float requested as an int, got %v", err)
+	}
+	_, err = RandomValue(reflect.TypeFor[int8](), FTAttributes{IntegerAttr: IntegerAttributesImpl[int64]{Min: 1000, Max: 1000}})
+	if !errors.As(err, &tme) || tme.Want != reflect.TypeFor[int8]() {
+		t.Errorf("expected TypeMismatchError for an int64 overflowing int8, got %v", err)
+	}
+	if _, err = RandomValue(nil, NewFTAttributes()); !errors.As(err, new(NilTypeError)) {
+		t.Errorf("expected NilTypeError, got %v", err)
+	}
+	attrs := FTAttributes{SliceAttr: SliceAttributes{MinLen: 1, MaxLen: 1, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 9}, ElementPreds: []p.Predicate{neverSatisfied{}}}}
+	if _, err = RandomValue(reflect.TypeFor[[]int](), attrs); !errors.As(err, new(ConstraintUnsatisfiableError)) {
+		t.Errorf("expected ConstraintUnsatisfiableError, got %v", err)
+	}
+}
+
+func TestGetAttributeGivenType_MaxRetries(t *testing.T) {
+	attrs := FTAttributes{
+		SliceAttr:  SliceAttributes{MinLen: 1, MaxLen: 1, ElementAttrs: StringAttributes{MinLen: 3, MaxLen: 3, AllowedRunes: []rune("ab"), UniqueChars: true}},
+		Generation: GenerationConfig{MaxRetries: 7},
+	}
+	sliceAttr, err := attrs.GetAttributeGivenType(reflect.TypeOf([]string{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = GenerateValue(sliceAttr)
+	if cue, ok := err.(ConstraintUnsatisfiableError); !ok || cue.Attempts != 7 || cue.Constraint != "UniqueChars" {
+		t.Errorf("expected the nested string to give up after 7 draws, got %v", err)
+	}
+}
+
+func TestGetAttributeGivenType_MaxNestingDepth(t *testing.T) {
+	level := func(inner any) SliceAttributes { return SliceAttributes{MinLen: 1, MaxLen: 3, ElementAttrs: inner} }
+	nested := level(level(level(IntegerAttributesImpl[int]{Min: 1, Max: 9})))
+	for _, tc := range []struct {
+		maxDepth  int
+		innerLens int
+	}{{maxDepth: 0, innerLens: 1}, {maxDepth: 2, innerLens: 0}} {
+		attrs := FTAttributes{SliceAttr: nested, Generation: GenerationConfig{MaxNestingDepth: tc.maxDepth}}
+		sliceAttr, err := attrs.GetAttributeGivenType(reflect.TypeOf([][][]int{}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for range 20 {
+			got := sliceAttr.GetRandomValue().([][][]int)
+			if len(got) == 0 {
+				t.Fatalf("expected the outer level to be populated, got %v", got)
+			}
+			for _, middle := range got {
+				if len(middle) == 0 {
+					t.Fatalf("expected the second level to be populated, got %v", got)
+				}
+				for _, inner := range middle {
+					if (len(inner) == 0) != (tc.innerLens == 0) {
+						t.Fatalf("MaxNestingDepth %d: unexpected innermost slice %v", tc.maxDepth, inner)
+					}
+				}
+			}
+		}
+	}
+	withBudget := FTAttributes{ArrayAttr: ArrayAttributes{Length: 2, ElementAttrs: level(IntegerAttributesImpl[int]{})}, MaxGeneratedElements: 10, Generation: GenerationConfig{MaxNestingDepth: 1}}
+	arrayAttr, err := withBudget.GetAttributeGivenType(reflect.TypeOf([2][]int{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := arrayAttr.GetRandomValue().([2][]int); len(got[0]) != 0 || len(got[1]) != 0 {
+		t.Errorf("expected slices nested in the array to be empty, got %v", got)
+	}
+}
+
+func TestGetAttributeGivenType_MaxCollectionLength(t *testing.T) {
+	cfg := GenerationConfig{MaxCollectionLength: 1000}
+	for _, tc := range []struct {
+		attrs FTAttributes
+		t     reflect.Type
+		want  string
+	}{
+		{FTAttributes{SliceAttr: SliceAttributes{MaxLen: 5000000, ElementAttrs: IntegerAttributesImpl[int]{}}, Generation: cfg}, reflect.TypeOf([]int{}), "requested slice length 5000000 exceeds MaxCollectionLength 1000"},
+		{FTAttributes{SliceAttr: SliceAttributes{MaxLen: 2, MaxCap: 2000, ElementAttrs: IntegerAttributesImpl[int]{}}, Generation: cfg}, reflect.TypeOf([]int{}), "requested slice capacity 2000 exceeds MaxCollectionLength 1000"},
+		{FTAttributes{MapAttr: MapAttributes{MaxSize: 1001, KeyAttrs: IntegerAttributesImpl[int]{}, ValueAttrs: BoolAttributes{}}, Generation: cfg}, reflect.TypeOf(map[int]bool{}), "requested map size 1001 exceeds MaxCollectionLength 1000"},
+		{FTAttributes{SliceAttr: SliceAttributes{MinLen: 1, MaxLen: 2, ElementAttrs: ArrayAttributes{Length: 4096, ElementAttrs: IntegerAttributesImpl[int]{}}}, Generation: cfg}, reflect.TypeOf([][4096]int{}), "requested array length 4096 exceeds MaxCollectionLength 1000"},
+	} {
+		attrs, err := tc.attrs.GetAttributeGivenType(tc.t)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var ctle CollectionTooLargeError
+		if _, err := GenerateValue(attrs); !errors.As(err, &ctle) || err.Error() != tc.want {
+			t.Errorf("expected %q, got %v", tc.want, err)
+		}
+		if v := reflect.ValueOf(attrs.GetRandomValue()); v.Type() != tc.t || v.Len() > 2 {
+			t.Errorf("expected GetRandomValue to fall back to a nil or short %v, got %v of length %d", tc.t, v.Type(), v.Len())
+		}
+	}
+	within := FTAttributes{SliceAttr: SliceAttributes{MinLen: 1000, MaxLen: 1000, ElementAttrs: IntegerAttributesImpl[int]{}}, Generation: cfg, MaxGeneratedElements: 2000}
+	attrs, err := within.GetAttributeGivenType(reflect.TypeOf([]int{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, err := GenerateValue(attrs); err != nil {
+		t.Errorf("expected a slice at the maximum length to be generated, got %v", err)
+	} else if len(v.([]int)) != 1000 {
+		t.Errorf("expected 1000 elements, got %d", len(v.([]int)))
+	}
+}
+
+func TestPredicateConstrainedAttributes(t *testing.T) {
+	attrs := PredicateConstrainedAttributes{Base: IntegerAttributesImpl[int]{Min: 0, Max: 100}, Preds: []p.Predicate{evenInt{}}}
+	if attrs.GetReflectType() != reflect.TypeOf(0) {
+		t.Errorf("expected the reflect type of Base, got %v", attrs.GetReflectType())
+	}
+	for range 50 {
+		if v := attrs.GetRandomValue().(int); v%2 != 0 {
+			t.Fatalf("expected only even values, got %d", v)
+		}
+	}
+	ft := FTAttributes{
+		SliceAttr:            SliceAttributes{MinLen: 4, MaxLen: 4, ElementAttrs: attrs},
+		MaxGeneratedElements: 2,
+		Generation:           GenerationConfig{MaxRetries: 50},
+	}
+	sliceAttr, err := ft.GetAttributeGivenType(reflect.TypeOf([]int{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 20 {
+		if got := sliceAttr.GetRandomValue().([]int); len(got) != 2 {
+			t.Fatalf("expected each accepted value to spend one unit of the element budget, got %v", got)
+		}
+	}
+	unsatisfiable := PredicateConstrainedAttributes{Base: IntegerAttributesImpl[int]{Min: 1, Max: 9}, Preds: []p.Predicate{neverSatisfied{}}}
+	if v := unsatisfiable.GetRandomValue(); v != 0 {
+		t.Errorf("expected the zero int once the retry budget is spent, got %#v", v)
+	}
+	_, err = GenerateValue(unsatisfiable)
+	if cue, ok := err.(ConstraintUnsatisfiableError); !ok || cue.Constraint != "Preds [never]" {
+		t.Errorf("expected ConstraintUnsatisfiableError, got %v", err)
+	}
+	if err := (PredicateConstrainedAttributes{}).Validate(); err == nil {
+		t.Error("expected Validate to reject a missing Base")
+	}
+	if (PredicateConstrainedAttributes{}).GetRandomValue() != nil {
+		t.Error("expected nil without a Base")
+	}
+}
+
+func TestNewFTAttributes_Options(t *testing.T) {
+	if !reflect.DeepEqual(NewFTAttributes(), NewFTAttributes([]FTOption{}...)) {
+		t.Error("expected no options to return the defaults")
+	}
+	attrs := NewFTAttributes(WithIntegerRange(3, 7), WithStringLength(4, 4))
+	if !reflect.DeepEqual(attrs.IntegerAttr, IntegerAttributesImpl[int]{Min: 3, Max: 7}) {
+		t.Errorf("unexpected integer attributes: %+v", attrs.IntegerAttr)
+	}
+	if attrs.StringAttr.MinLen != 4 || attrs.StringAttr.MaxLen != 4 {
+		t.Errorf("unexpected string attributes: %+v", attrs.StringAttr)
+	}
+	for range 20 {
+		if v := attrs.IntegerAttr.GetRandomValue().(int); v < 3 || v > 7 {
+			t.Fatalf("expected integers in [3, 7], got %d", v)
+		}
+		if s := attrs.StringAttr.GetRandomValue().(string); len(s) != 4 {
+			t.Fatalf("expected strings of length 4, got %q", s)
+		}
+	}
+}
+
+func TestNewFTAttributes_WithSeed(t *testing.T) {
+	generate := func() []any {
+		attrs := NewFTAttributes(WithSeed(42))
+		sliceAttr, _ := attrs.GetAttributeGivenType(reflect.TypeOf([]int{}))
+		mapAttr, _ := attrs.GetAttributeGivenType(reflect.TypeOf(map[string]int{}))
+		return []any{sliceAttr.GetRandomValue(), mapAttr.GetRandomValue()}
+	}
+	first, second := generate(), generate()
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("expected the same seed to reproduce the same values, got %v and %v", first, second)
+	}
+}
+
+func TestNewFTAttributes_WithDeterministic(t *testing.T) {
+	structType := reflect.StructOf([]reflect.StructField{
+		{Name: "A", Type: reflect.TypeOf(0)},
+		{Name: "B", Type: reflect.TypeOf("")},
+		{Name: "C", Type: reflect.TypeOf(0.0)},
+	})
+	generate := func() []any {
+		attrs := NewFTAttributes(WithDeterministic(42))
+		attrs.StructAttr = StructAttributes{FieldAttrs: map[string]any{
+			"C": FloatAttributesImpl[float64]{Min: 0, Max: 1},
+			"A": IntegerAttributesImpl[int]{Min: 0, Max: 100},
+			"B": StringAttributes{MinLen: 1, MaxLen: 5},
+		}}
+		structAttr, err := attrs.GetAttributeGivenType(structType)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := structAttr.GetReflectType(); got != structType {
+			t.Fatalf("expected fields laid out in name order, got %v", got)
+		}
+		mapAttr, _ := attrs.GetAttributeGivenType(reflect.TypeOf(map[string][]int{}))
+		return []any{structAttr.GetRandomValue(), structAttr.GetRandomValue(), mapAttr.GetRandomValue()}
+	}
+	first := generate()
+	for range 10 {
+		if second := generate(); !reflect.DeepEqual(first, second) {
+			t.Fatalf("expected deterministic generation to reproduce the same values, got %v and %v", first, second)
+		}
+	}
+}
+
+func TestDefaultMaxSizes(t *testing.T) {
+	prevString, prevSlice, prevMap := DefaultMaxStringLen, DefaultMaxSliceLen, DefaultMaxMapSize
+	t.Cleanup(func() {
+		DefaultMaxStringLen, DefaultMaxSliceLen, DefaultMaxMapSize = prevString, prevSlice, prevMap
+	})
+	DefaultMaxStringLen, DefaultMaxSliceLen, DefaultMaxMapSize = 40, 30, 20
+	attrs := NewFTAttributes()
+	if attrs.StringAttr.MaxLen != 40 || attrs.SliceAttr.MaxLen != 30 || attrs.MapAttr.MaxSize != 20 {
+		t.Errorf("expected NewFTAttributes to use the default maxima, got %d, %d, %d",
+			attrs.StringAttr.MaxLen, attrs.SliceAttr.MaxLen, attrs.MapAttr.MaxSize)
+	}
+	longest := 0
+	for range 200 {
+		longest = max(longest, len((SliceAttributes{ElementAttrs: IntegerAttributesImpl[int]{}}).GetRandomValue().([]int)))
+	}
+	if longest <= 5 || longest > 30 {
+		t.Errorf("expected unset slice maxima to fall back to DefaultMaxSliceLen, longest was %d", longest)
+	}
+	if _, maxLen := (StringAttributes{}).getLengthBounds(); maxLen != 40 {
+		t.Errorf("expected unset string maxima to fall back to DefaultMaxStringLen, got %d", maxLen)
+	}
+	if _, maxSize := (MapAttributes{}).getMapSizeBounds(); maxSize != 20 {
+		t.Errorf("expected unset map maxima to fall back to DefaultMaxMapSize, got %d", maxSize)
+	}
+}
+
+type stringerStruct struct {
+	Name string
+}
+
+func (s stringerStruct) String() string { return s.Name }
+
+func TestGetAttributeGivenType_Interface(t *testing.T) {
+	stringer := reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	attrs := NewFTAttributes()
+	if _, err := attrs.GetAttributeGivenType(stringer); err == nil {
+		t.Error("expected an error when no configured type implements the interface")
+	}
+	attrs.StructAttr = StructAttributes{
+		TargetType: reflect.TypeOf(stringerStruct{}),
+		FieldAttrs: map[string]any{"Name": StringAttributes{MinLen: 4, MaxLen: 4}},
+	}
+	got, err := attrs.GetAttributeGivenType(stringer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s, ok := got.GetRandomValue().(fmt.Stringer)
+	if !ok {
+		t.Fatalf("expected a fmt.Stringer, got %T", got.GetRandomValue())
+	}
+	if len(s.String()) != 4 {
+		t.Errorf("expected the String method of the target type, got %q", s.String())
+	}
+}
+
+type codeError struct {
+	Code int
+}
+
+func (e *codeError) Error() string { return fmt.Sprintf("code %d", e.Code) }
+
+func TestGetAttributeGivenType_InterfaceTypedNil(t *testing.T) {
+	errType := reflect.TypeOf((*error)(nil)).Elem()
+	attrs := NewFTAttributes()
+	attrs.PointerAttr = PointerAttributes{Depth: 1, Inner: StructAttributes{TargetType: reflect.TypeOf(codeError{}), FillUnlisted: true}}
+	attrs.InterfaceAttr = InterfaceAttributes{NilProbability: 0.3, TypedNilProbability: 0.3}
+	got, err := attrs.GetAttributeGivenType(errType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.GetReflectType() != errType {
+		t.Errorf("expected the interface type, got %v", got.GetReflectType())
+	}
+	var nils, typedNils, values int
+	for range 300 {
+		switch v := got.GetRandomValue().(type) {
+		case nil:
+			nils++
+		case *codeError:
+			if v == nil {
+				typedNils++
+				if err := error(v); err == nil {
+					t.Fatal("expected a typed nil to be a non-nil error")
+				}
+			} else {
+				values++
+			}
+		default:
+			t.Fatalf("unexpected value of type %T", v)
+		}
+	}
+	if nils == 0 || typedNils == 0 || values == 0 {
+		t.Errorf("expected nil, typed nil and regular values, got %d, %d and %d", nils, typedNils, values)
+	}
+
+	attrs.StructAttr = StructAttributes{TargetType: reflect.TypeOf(stringerStruct{})}
+	attrs.InterfaceAttr = InterfaceAttributes{TypedNilProbability: 1}
+	stringer, _ := attrs.GetAttributeGivenType(reflect.TypeOf((*fmt.Stringer)(nil)).Elem())
+	if v, ok := stringer.GetRandomValue().(*stringerStruct); !ok || v != nil {
+		t.Errorf("expected a nil *stringerStruct for a value-receiver implementation, got %#v", stringer.GetRandomValue())
+	}
+
+	attrs.InterfaceAttr = InterfaceAttributes{NilProbability: 0.7, TypedNilProbability: 0.7}
+	var iae InvalidAttributeError
+	if _, err := attrs.GetAttributeGivenType(errType); !errors.As(err, &iae) {
+		t.Errorf("expected InvalidAttributeError for probabilities above 1, got %v", err)
+	}
+}
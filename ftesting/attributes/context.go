@@ -0,0 +1,100 @@
+package attributes
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// defaultMaxContextDelay is the longest delay before a generated context is cancelled or
+// expires when ContextAttributes leaves MaxDelay unset.
+const defaultMaxContextDelay = 10 * time.Millisecond
+
+// contextType is the exact type of context.Context parameters.
+var contextType = reflect.TypeFor[context.Context]()
+
+// ContextAttributes configures the contexts passed to context.Context parameters. By
+// default every parameter receives Parent, or context.Background() when Parent is nil.
+// The probabilities mix in contexts exercising cancellation handling instead.
+//
+// Fields:
+//   - Parent: The context the generated contexts derive from; nil uses context.Background()
+//   - CancelledProbability: Probability of a context that is already cancelled
+//   - LateCancelProbability: Probability of a context cancelled after a random delay up to
+//     MaxDelay, so that cancellation arrives while the function is running
+//   - DeadlineProbability: Probability of a context whose deadline expires after a random
+//     delay up to MaxDelay
+//   - MaxDelay: The longest delay of late cancellations and deadlines; defaults to 10ms
+//     when not positive
+//
+// The probabilities must lie in [0, 1] and add up to at most 1; the remaining contexts
+// are Parent itself. The parameter is detected by its exact type, context.Context.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes()
+//	attrs.ContextAttr = ContextAttributes{CancelledProbability: 0.2, DeadlineProbability: 0.3, MaxDelay: time.Millisecond}
+type ContextAttributes struct {
+	Parent                context.Context
+	CancelledProbability  float64
+	LateCancelProbability float64
+	DeadlineProbability   float64
+	MaxDelay              time.Duration
+}
+
+func (a ContextAttributes) GetAttributes() any                   { return a }
+func (a ContextAttributes) GetReflectType() reflect.Type         { return contextType }
+func (a ContextAttributes) GetDefaultImplementation() Attributes { return ContextAttributes{} }
+
+func (a ContextAttributes) GetRandomValue() any {
+	parent := a.Parent
+	if parent == nil {
+		parent = context.Background()
+	}
+	r := rng.Float64()
+	switch {
+	case r < a.CancelledProbability:
+		ctx, cancel := context.WithCancel(parent)
+		cancel()
+		return ctx
+	case r < a.CancelledProbability+a.LateCancelProbability:
+		ctx, cancel := context.WithCancel(parent)
+		time.AfterFunc(a.randomDelay(), cancel)
+		return ctx
+	case r < a.CancelledProbability+a.LateCancelProbability+a.DeadlineProbability:
+		ctx, cancel := context.WithTimeout(parent, a.randomDelay())
+		context.AfterFunc(ctx, cancel)
+		return ctx
+	}
+	return parent
+}
+
+// Configured reports whether any field is set, i.e. whether the attributes generate
+// anything other than context.Background().
+func (a ContextAttributes) Configured() bool {
+	return a.Parent != nil || a.CancelledProbability != 0 || a.LateCancelProbability != 0 || a.DeadlineProbability != 0 || a.MaxDelay != 0
+}
+
+// Validate reports an InvalidAttributeError when a probability lies outside [0, 1] or
+// they add up to more than 1.
+func (a ContextAttributes) Validate() error {
+	probabilities := []float64{a.CancelledProbability, a.LateCancelProbability, a.DeadlineProbability}
+	for _, p := range probabilities {
+		if p < 0 || p > 1 {
+			return InvalidAttributeError{Attribute: "ContextAttributes", Reason: "probabilities must lie in [0, 1]"}
+		}
+	}
+	if probabilities[0]+probabilities[1]+probabilities[2] > 1 {
+		return InvalidAttributeError{Attribute: "ContextAttributes", Reason: "probabilities add up to more than 1"}
+	}
+	return nil
+}
+
+// randomDelay returns a random delay in [0, MaxDelay].
+func (a ContextAttributes) randomDelay() time.Duration {
+	maxDelay := a.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxContextDelay
+	}
+	return time.Duration(rng.Int63n(int64(maxDelay) + 1))
+}
@@ -0,0 +1,64 @@
+package attributes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestContextAttributes_Default(t *testing.T) {
+	got, err := NewFTAttributes().GetAttributeGivenType(contextType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ctx := got.GetRandomValue(); ctx != context.Background() {
+		t.Errorf("expected context.Background() by default, got %v", ctx)
+	}
+	type key struct{}
+	parent := context.WithValue(context.Background(), key{}, "v")
+	if ctx := (ContextAttributes{Parent: parent}).GetRandomValue(); ctx != parent {
+		t.Errorf("expected the parent context, got %v", ctx)
+	}
+}
+
+func TestContextAttributes_Cancellation(t *testing.T) {
+	cancelled := ContextAttributes{CancelledProbability: 1}.GetRandomValue().(context.Context)
+	if !errors.Is(cancelled.Err(), context.Canceled) {
+		t.Errorf("expected an already cancelled context, got %v", cancelled.Err())
+	}
+	late := ContextAttributes{LateCancelProbability: 1, MaxDelay: time.Millisecond}.GetRandomValue().(context.Context)
+	<-late.Done()
+	if !errors.Is(late.Err(), context.Canceled) {
+		t.Errorf("expected a context cancelled after a delay, got %v", late.Err())
+	}
+	expiring := ContextAttributes{DeadlineProbability: 1, MaxDelay: time.Millisecond}.GetRandomValue().(context.Context)
+	if _, ok := expiring.Deadline(); !ok {
+		t.Error("expected a context with a deadline")
+	}
+	<-expiring.Done()
+	if !errors.Is(expiring.Err(), context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to expire, got %v", expiring.Err())
+	}
+}
+
+func TestContextAttributes_Validate(t *testing.T) {
+	var iae InvalidAttributeError
+	for _, attrs := range []ContextAttributes{
+		{CancelledProbability: -0.1},
+		{DeadlineProbability: 1.5},
+		{CancelledProbability: 0.5, LateCancelProbability: 0.3, DeadlineProbability: 0.3},
+	} {
+		if err := attrs.Validate(); !errors.As(err, &iae) {
+			t.Errorf("expected InvalidAttributeError for %+v, got %v", attrs, err)
+		}
+	}
+	attrs := NewFTAttributes()
+	attrs.ContextAttr = ContextAttributes{CancelledProbability: 2}
+	if _, err := attrs.GetAttributeGivenType(contextType); err == nil {
+		t.Error("expected the context attributes to be validated")
+	}
+	if (ContextAttributes{}).Configured() || !(ContextAttributes{MaxDelay: time.Second}).Configured() {
+		t.Error("expected only attributes with a field set to be configured")
+	}
+}
@@ -0,0 +1,84 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// IotaEnumAttributes generates the declared constants of an enum-like integer type, such
+// as a type Color int whose values are declared with iota. Go does not expose the
+// constants of a type through reflection, so their underlying values are listed in
+// Values. Every generated value has exactly the named type Type, which makes it
+// assignable to parameters of that type; register the attributes for Type with
+// FTAttributes.RegisterType so that such parameters receive only valid constants.
+//
+// Fields:
+//   - Type: The named integer type of the constants
+//   - Values: The underlying values of the declared constants; each is equally likely
+//
+// Example usage:
+//
+//	type Color int
+//	const (
+//	    Red Color = iota
+//	    Green
+//	    Blue
+//	)
+//	colors := IotaEnumAttributes{Type: reflect.TypeOf(Red), Values: []int64{int64(Red), int64(Green), int64(Blue)}}
+//	attrs := NewFTAttributes()
+//	attrs.RegisterType(colors.Type, colors)
+type IotaEnumAttributes struct {
+	Type   reflect.Type
+	Values []int64
+}
+
+func (a IotaEnumAttributes) GetAttributes() any           { return a }
+func (a IotaEnumAttributes) GetReflectType() reflect.Type { return a.Type }
+
+// GetDefaultImplementation returns empty attributes: the constants of an enum type cannot
+// be guessed, so there is no meaningful default.
+func (a IotaEnumAttributes) GetDefaultImplementation() Attributes {
+	return IotaEnumAttributes{}
+}
+
+// GetRandomValue returns one of Values as a Type, or nil when Type is not an integer
+// type or Values is empty.
+func (a IotaEnumAttributes) GetRandomValue() any {
+	if !isIntegerType(a.Type) || len(a.Values) == 0 {
+		return nil
+	}
+	v := a.Values[rng.Intn(len(a.Values))]
+	value := reflect.New(a.Type).Elem()
+	if isUnsigned(a.Type) {
+		value.SetUint(uint64(v))
+	} else {
+		value.SetInt(v)
+	}
+	return value.Interface()
+}
+
+// Validate reports an InvalidAttributeError when Type is not an integer type, Values is
+// empty, or a value does not fit Type.
+func (a IotaEnumAttributes) Validate() error {
+	invalid := func(reason string) error {
+		return InvalidAttributeError{Attribute: "IotaEnumAttributes", Reason: reason}
+	}
+	if !isIntegerType(a.Type) {
+		return invalid(fmt.Sprintf("Type must be an integer type, got %v", a.Type))
+	}
+	if len(a.Values) == 0 {
+		return invalid("Values must list at least one constant")
+	}
+	zero := reflect.New(a.Type).Elem()
+	for _, v := range a.Values {
+		if (isUnsigned(a.Type) && (v < 0 || zero.OverflowUint(uint64(v)))) || (!isUnsigned(a.Type) && zero.OverflowInt(v)) {
+			return invalid(fmt.Sprintf("value %d does not fit %v", v, a.Type))
+		}
+	}
+	return nil
+}
+
+// isIntegerType reports whether t is a signed or unsigned integer type.
+func isIntegerType(t reflect.Type) bool {
+	return t != nil && t.Kind() >= reflect.Int && t.Kind() <= reflect.Uintptr
+}
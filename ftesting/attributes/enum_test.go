@@ -0,0 +1,67 @@
+package attributes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type color int
+
+const (
+	red color = iota
+	green
+	blue
+)
+
+func TestIotaEnumAttributes(t *testing.T) {
+	colors := IotaEnumAttributes{Type: reflect.TypeOf(red), Values: []int64{int64(red), int64(green), int64(blue)}}
+	attrs := NewFTAttributes()
+	attrs.RegisterType(colors.Type, colors)
+	got, err := attrs.GetAttributeGivenType(reflect.TypeOf(red))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	seen := map[color]bool{}
+	for range 200 {
+		c, ok := got.GetRandomValue().(color)
+		if !ok || c < red || c > blue {
+			t.Fatalf("expected a declared color, got %#v", c)
+		}
+		seen[c] = true
+	}
+	if len(seen) != 3 {
+		t.Errorf("expected every declared color to be generated, got %v", seen)
+	}
+	type level uint8
+	if v := (IotaEnumAttributes{Type: reflect.TypeOf(level(0)), Values: []int64{200}}).GetRandomValue(); v != level(200) {
+		t.Errorf("expected an unsigned enum value, got %#v", v)
+	}
+	if (IotaEnumAttributes{Type: reflect.TypeOf(red)}).GetRandomValue() != nil {
+		t.Error("expected no value without Values")
+	}
+	if (IotaEnumAttributes{Type: reflect.TypeOf(""), Values: []int64{0}}).GetRandomValue() != nil {
+		t.Error("expected no value for a non-integer Type")
+	}
+	if def := colors.GetDefaultImplementation(); !reflect.DeepEqual(def, IotaEnumAttributes{}) || def.GetRandomValue() != nil {
+		t.Errorf("expected empty default attributes, got %+v", def)
+	}
+}
+
+func TestIotaEnumAttributes_Validate(t *testing.T) {
+	var iae InvalidAttributeError
+	for _, attrs := range []IotaEnumAttributes{
+		{Values: []int64{0}},
+		{Type: reflect.TypeOf(""), Values: []int64{0}},
+		{Type: reflect.TypeOf(red)},
+		{Type: reflect.TypeOf(int8(0)), Values: []int64{128}},
+		{Type: reflect.TypeOf(uint(0)), Values: []int64{-1}},
+	} {
+		if err := attrs.Validate(); !errors.As(err, &iae) {
+			t.Errorf("expected InvalidAttributeError for %+v, got %v", attrs, err)
+		}
+	}
+	if err := (IotaEnumAttributes{Type: reflect.TypeOf(red), Values: []int64{0, 1}}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
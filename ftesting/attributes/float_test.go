@@ -1,6 +1,7 @@
 package attributes
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -66,3 +67,169 @@ func TestFloatAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestFloatAttributes_LogScale(t *testing.T) {
+	attr := FloatAttributesImpl[float64]{Min: 1e-6, Max: 1e6, LogScale: true}
+	if err := attr.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	const iterations = 2000
+	small := 0
+	for i := 0; i < iterations; i++ {
+		f := attr.GetRandomValue().(float64)
+		if f < 1e-6 || f > 1e6 {
+			t.Fatalf("value %v outside [1e-6, 1e6]", f)
+		}
+		if f < 1 {
+			small++
+		}
+	}
+	// Half of the orders of magnitude lie below 1, linear sampling would almost never get there.
+	if small < iterations/4 || small > 3*iterations/4 {
+		t.Errorf("expected roughly half the values below 1, got %d of %d", small, iterations)
+	}
+}
+
+func TestFloatAttributes_LogScaleFloat32(t *testing.T) {
+	attr := FloatAttributesImpl[float32]{Min: 0.001, Max: 1000, LogScale: true}
+	for i := 0; i < 100; i++ {
+		f := attr.GetRandomValue().(float32)
+		if f < 0.001 || f > 1000 {
+			t.Fatalf("value %v outside [0.001, 1000]", f)
+		}
+	}
+}
+
+func TestFloatAttributes_LogScaleRequiresPositiveMin(t *testing.T) {
+	for _, min := range []float64{0, -1} {
+		attr := FloatAttributesImpl[float64]{Min: min, Max: 10, LogScale: true}
+		if _, ok := attr.Validate().(InvalidAttributeError); !ok {
+			t.Errorf("expected InvalidAttributeError for Min %v", min)
+		}
+		if got := attr.GetRandomValue(); got != float64(0) {
+			t.Errorf("expected zero value for invalid log-scale range, got %v", got)
+		}
+	}
+	if err := (FloatAttributesImpl[float64]{Min: -1, Max: 1}).Validate(); err != nil {
+		t.Errorf("expected no validation error without LogScale, got %v", err)
+	}
+}
+
+func TestFloatAttributes_NonZeroResamples(t *testing.T) {
+	// Half of the draws from this range round to a float32 zero
+	attrs := FloatAttributesImpl[float32]{Min: 0, Max: 1e-45, NonZero: true}
+	for range 100 {
+		if v := attrs.GetRandomValue().(float32); v == 0 {
+			t.Fatal("expected NonZero to redraw values that round to zero")
+		}
+	}
+}
+
+func TestFloatAttributes_ExcludedRange(t *testing.T) {
+	attrs := FloatAttributesImpl[float64]{Min: -1, Max: 1, ExcludeMin: -0.1, ExcludeMax: 0.1}
+	if err := attrs.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	var below, above int
+	for range 1000 {
+		v := attrs.GetRandomValue().(float64)
+		if v < -1 || v > 1 || (v > -0.1 && v < 0.1) {
+			t.Fatalf("expected values in [-1, -0.1] or [0.1, 1], got %v", v)
+		}
+		if v < 0 {
+			below++
+		} else {
+			above++
+		}
+	}
+	if below == 0 || above == 0 {
+		t.Errorf("expected both sides of the hole to be generated, got %d below and %d above", below, above)
+	}
+	logAttrs := FloatAttributesImpl[float32]{Min: 1, Max: 1000, LogScale: true, ExcludeMin: 10, ExcludeMax: 100}
+	for range 1000 {
+		if v := logAttrs.GetRandomValue().(float32); v < 1 || v > 1000 || (v > 10.001 && v < 99.99) {
+			t.Fatalf("expected log-scale values outside (10, 100), got %v", v)
+		}
+	}
+}
+
+func TestFloatAttributes_ExcludedRangeValidation(t *testing.T) {
+	for _, attrs := range []FloatAttributesImpl[float64]{
+		{Min: 0, Max: 1, ExcludeMin: 0.5, ExcludeMax: 0.2},
+		{Min: 0, Max: 1, ExcludeMin: -0.5, ExcludeMax: 0.5},
+		{Min: 0, Max: 1, ExcludeMin: 0.5, ExcludeMax: 2},
+		{Min: 0, Max: 1, ExcludeMin: 0, ExcludeMax: 1},
+	} {
+		if _, ok := attrs.Validate().(InvalidAttributeError); !ok {
+			t.Errorf("expected InvalidAttributeError for hole (%v, %v) in [%v, %v]", attrs.ExcludeMin, attrs.ExcludeMax, attrs.Min, attrs.Max)
+		}
+	}
+	if err := (FloatAttributesImpl[float64]{Min: 0, Max: 1, ExcludeMin: 0, ExcludeMax: 0.5}).Validate(); err != nil {
+		t.Errorf("expected a hole at the edge of the range to be valid, got %v", err)
+	}
+}
+
+func TestFloatAttributes_ExcludedRangeOutsideRangeWithoutValidate(t *testing.T) {
+	for _, attrs := range []FloatAttributesImpl[float64]{
+		{Min: 0, Max: 1, ExcludeMin: -0.5, ExcludeMax: 0.5},
+		{Min: 0, Max: 1, ExcludeMin: 0.5, ExcludeMax: 2},
+		{Min: 0, Max: 1, ExcludeMin: 2, ExcludeMax: 3},
+		{Min: 0, Max: 1, ExcludeMin: -1, ExcludeMax: 2},
+		{Min: 1, Max: 10, LogScale: true, ExcludeMin: -5, ExcludeMax: 5},
+	} {
+		for range 200 {
+			if v := attrs.GetRandomValue().(float64); v < attrs.Min || v > attrs.Max {
+				t.Fatalf("expected a value in [%v, %v] for hole (%v, %v), got %v", attrs.Min, attrs.Max, attrs.ExcludeMin, attrs.ExcludeMax, v)
+			}
+		}
+	}
+}
+
+func TestFloatAttributes_Step(t *testing.T) {
+	attrs := FloatAttributesImpl[float64]{Min: -1, Max: 1, Step: 0.25}
+	if err := attrs.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	seen := map[float64]int{}
+	for range 2000 {
+		v := attrs.GetRandomValue().(float64)
+		if v < -1 || v > 1 || v != math.Round(v/0.25)*0.25 {
+			t.Fatalf("expected multiples of 0.25 in [-1, 1], got %v", v)
+		}
+		seen[v]++
+	}
+	if len(seen) != 9 {
+		t.Errorf("expected all 9 multiples to be generated, got %v", seen)
+	}
+	holed := FloatAttributesImpl[float32]{Min: 0.5, Max: 10, Step: 1, ExcludeMin: 2, ExcludeMax: 8}
+	for range 500 {
+		if v := holed.GetRandomValue().(float32); v != 1 && v != 2 && v != 8 && v != 9 && v != 10 {
+			t.Fatalf("expected multiples of 1 in [0.5, 10] outside (2, 8), got %v", v)
+		}
+	}
+}
+
+func TestFloatAttributes_InfiniteStepWithoutValidate(t *testing.T) {
+	attrs := FloatAttributesImpl[float64]{Min: -1, Max: 1, Step: math.Inf(1)}
+	if v := attrs.GetRandomValue().(float64); v != 0 {
+		t.Errorf("expected zero for an infinite Step, got %v", v)
+	}
+	if _, ok := attrs.Validate().(InvalidAttributeError); !ok {
+		t.Error("expected InvalidAttributeError for an infinite Step")
+	}
+}
+
+func TestFloatAttributes_StepValidation(t *testing.T) {
+	for _, attrs := range []FloatAttributesImpl[float64]{
+		{Min: 0, Max: 1, Step: -0.1},
+		{Min: 0, Max: 1, Step: math.NaN()},
+		{Min: 1, Max: 10, Step: 1, LogScale: true},
+		{Min: 0.1, Max: 0.9, Step: 1},
+		{Min: 0, Max: 1e6, Step: 1e-12},
+		{Min: 0.5, Max: 1.5, Step: 1, ExcludeMin: 0.6, ExcludeMax: 1.4},
+	} {
+		if _, ok := attrs.Validate().(InvalidAttributeError); !ok {
+			t.Errorf("expected InvalidAttributeError for Step %v in [%v, %v]", attrs.Step, attrs.Min, attrs.Max)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// FuncAttributes configures the generation of functions, such as the callbacks passed to
+// higher-order functions. Every generated function returns fresh random values on each
+// call and, when Recorder is set, records its invocations, turning it into a spy that
+// the test can inspect after calling the function under test.
+//
+// Fields:
+//   - TargetType: The function type to generate; GetAttributeGivenType sets it to the
+//     parameter type when it is nil
+//   - ReturnAttrs: Optional attributes for the results, one per result; results without
+//     attributes are generated from the defaults for their type (interfaces such as error
+//     are always nil)
+//   - Recorder: Optional recorder receiving the arguments of every call
+//
+// Example usage:
+//
+//	rec := &CallRecorder{}
+//	attrs := FuncAttributes{
+//	    TargetType:  reflect.TypeOf(func(int) int { return 0 }),
+//	    ReturnAttrs: []any{IntegerAttributesImpl[int]{Min: 0, Max: 9}},
+//	    Recorder:    rec,
+//	}
+//	f := attrs.GetRandomValue().(func(int) int)
+//	Map([]int{1, 2, 3}, f)
+//	rec.Count() // 3
+type FuncAttributes struct {
+	TargetType  reflect.Type
+	ReturnAttrs []any
+	Recorder    *CallRecorder
+}
+
+func (a FuncAttributes) GetAttributes() any                   { return a }
+func (a FuncAttributes) GetReflectType() reflect.Type         { return a.TargetType }
+func (a FuncAttributes) GetDefaultImplementation() Attributes { return FuncAttributes{} }
+
+func (a FuncAttributes) GetRandomValue() any {
+	if a.TargetType == nil || a.TargetType.Kind() != reflect.Func {
+		return nil
+	}
+	results := make([]Attributes, a.TargetType.NumOut())
+	for i := range results {
+		if i < len(a.ReturnAttrs) && a.ReturnAttrs[i] != nil {
+			results[i], _ = a.ReturnAttrs[i].(Attributes)
+		} else {
+			results[i] = attributesForType(a.TargetType.Out(i), 0)
+		}
+	}
+	fn := -1
+	if a.Recorder != nil {
+		fn = a.Recorder.newFunc()
+	}
+	return reflect.MakeFunc(a.TargetType, func(args []reflect.Value) []reflect.Value {
+		if a.Recorder != nil {
+			a.Recorder.record(fn, args)
+		}
+		out := make([]reflect.Value, len(results))
+		for i, attrs := range results {
+			out[i] = randomValueOf(attrs, a.TargetType.Out(i))
+		}
+		return out
+	}).Interface()
+}
+
+// Validate reports an InvalidAttributeError when TargetType is not a function type or
+// ReturnAttrs holds more entries than the function has results.
+func (a FuncAttributes) Validate() error {
+	if a.TargetType == nil {
+		return nil
+	}
+	if a.TargetType.Kind() != reflect.Func {
+		return InvalidAttributeError{Attribute: "FuncAttributes", Reason: "TargetType must be a function type"}
+	}
+	if len(a.ReturnAttrs) > a.TargetType.NumOut() {
+		return InvalidAttributeError{
+			Attribute: "FuncAttributes",
+			Reason:    fmt.Sprintf("%d return attributes for %d results", len(a.ReturnAttrs), a.TargetType.NumOut()),
+		}
+	}
+	return nil
+}
+
+// forType sets TargetType to the function type t when it is not configured
+func (a FuncAttributes) forType(t reflect.Type) FuncAttributes {
+	if a.TargetType == nil {
+		a.TargetType = t
+	}
+	return a
+}
+
+// Call is one invocation of a function generated by FuncAttributes.
+//
+// Fields:
+//   - Func: The index of the function called, counting from 0 the functions generated
+//     with the recorder
+//   - Args: The arguments of the call; the variadic arguments are passed as one slice
+type Call struct {
+	Func int
+	Args []any
+}
+
+// CallRecorder records the calls made to the functions generated by FuncAttributes. It
+// is safe for concurrent use, so the function under test may call the generated
+// functions from several goroutines. The zero value is ready to use.
+//
+// Example usage:
+//
+//	rec := &CallRecorder{}
+//	property := func(xs []int, f func(int) int) bool {
+//	    rec.Reset()
+//	    Map(xs, f)
+//	    return rec.Count() == len(xs)
+//	}
+type CallRecorder struct {
+	mu    sync.Mutex
+	calls []Call
+	funcs int
+}
+
+// Calls returns the recorded calls in the order they were made.
+func (r *CallRecorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.calls)
+}
+
+// Count returns the number of recorded calls.
+func (r *CallRecorder) Count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.calls)
+}
+
+// Reset forgets the recorded calls. Functions generated earlier keep recording, with the
+// same indices.
+func (r *CallRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// newFunc returns the index of a newly generated function.
+func (r *CallRecorder) newFunc() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.funcs++
+	return r.funcs - 1
+}
+
+// record appends a call to function fn with args.
+func (r *CallRecorder) record(fn int, args []reflect.Value) {
+	call := Call{Func: fn, Args: make([]any, len(args))}
+	for i, arg := range args {
+		call.Args[i] = arg.Interface()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+}
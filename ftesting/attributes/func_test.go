@@ -0,0 +1,69 @@
+package attributes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFuncAttributes_Recorder(t *testing.T) {
+	rec := &CallRecorder{}
+	attrs := FuncAttributes{
+		TargetType:  reflect.TypeOf(func(int, string) (int, error) { return 0, nil }),
+		ReturnAttrs: []any{IntegerAttributesImpl[int]{Min: 3, Max: 3}},
+		Recorder:    rec,
+	}
+	first := attrs.GetRandomValue().(func(int, string) (int, error))
+	second := attrs.GetRandomValue().(func(int, string) (int, error))
+	if n, err := first(1, "a"); n != 3 || err != nil {
+		t.Errorf("expected results from the return attributes, got %d, %v", n, err)
+	}
+	second(2, "b")
+	first(3, "c")
+	want := []Call{{Func: 0, Args: []any{1, "a"}}, {Func: 1, Args: []any{2, "b"}}, {Func: 0, Args: []any{3, "c"}}}
+	if got := rec.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected calls %v, got %v", want, got)
+	}
+	rec.Reset()
+	second(4, "d")
+	if got := rec.Calls(); rec.Count() != 1 || got[0].Func != 1 {
+		t.Errorf("expected only the calls made after Reset, got %v", got)
+	}
+}
+
+func TestFuncAttributes_Validate(t *testing.T) {
+	var iae InvalidAttributeError
+	for _, attrs := range []FuncAttributes{
+		{TargetType: reflect.TypeOf(0)},
+		{TargetType: reflect.TypeOf(func() {}), ReturnAttrs: []any{IntegerAttributesImpl[int]{}}},
+	} {
+		if err := attrs.Validate(); !errors.As(err, &iae) {
+			t.Errorf("expected InvalidAttributeError for %+v, got %v", attrs, err)
+		}
+	}
+	if (FuncAttributes{}).GetRandomValue() != nil {
+		t.Error("expected nil without a target type")
+	}
+}
+
+func TestGetAttributeGivenType_Func(t *testing.T) {
+	rec := &CallRecorder{}
+	attrs := NewFTAttributes()
+	attrs.FuncAttr = FuncAttributes{Recorder: rec}
+	ft := reflect.TypeOf(func(float64) bool { return false })
+	got, err := attrs.GetAttributeGivenType(ft)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f, ok := got.GetRandomValue().(func(float64) bool)
+	if !ok {
+		t.Fatalf("expected a func(float64) bool, got %T", got.GetRandomValue())
+	}
+	f(1.5)
+	if rec.Count() != 1 {
+		t.Errorf("expected the call to be recorded, got %v", rec.Calls())
+	}
+	if got, err := NewFTAttributes().GetAttributeGivenType(ft); err != nil || got.GetReflectType() != ft {
+		t.Errorf("expected default function attributes for %v, got %v, %v", ft, got, err)
+	}
+}
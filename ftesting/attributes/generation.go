@@ -0,0 +1,390 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+
+	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
+	"github.com/laiambryant/gotestutils/utils"
+)
+
+// defaultMaxRetries is the number of rejected draws tolerated by constrained
+// generators when GenerationConfig.MaxRetries is not set.
+const defaultMaxRetries = 100
+
+// GenerationConfig holds settings shared by every generator configured through an
+// FTAttributes.
+//
+// Fields:
+//   - MaxRetries: The number of draws a constrained generator (ElementPreds, KeyPreds,
+//     ValuePreds, UniqueChars, NonZero floats) makes before giving up with a
+//     ConstraintUnsatisfiableError, and the number of consecutive duplicate Unique slice
+//     elements, map keys or UniqueValues values after which the collection is returned
+//     smaller; 0 uses the default of 100
+//   - MaxNestingDepth: The number of nested slice, map, array and struct levels that are
+//     populated; collections nested deeper are generated empty (zero-valued for arrays
+//     and structs). 0 leaves nesting unbounded
+//   - MaxCollectionLength: The largest length (or capacity) a single slice or array, or
+//     size a single map, may be configured with. Generating a collection configured
+//     beyond it fails with a CollectionTooLargeError instead of allocating it, which
+//     guards against typos such as MaxLen: 100000000; GetRandomValue generates a nil
+//     collection instead. 0 leaves lengths unbounded
+type GenerationConfig struct {
+	MaxRetries          int
+	MaxNestingDepth     int
+	MaxCollectionLength int
+}
+
+// generation carries the settings of one top-level generation down to nested attributes.
+// depth is the number of collections enclosing the attributes it is bound to,
+// deterministic fixes the order in which struct fields are laid out and generated, and
+// report collects the failures of the generators bound to it.
+type generation struct {
+	budget        *elementBudget
+	report        *generationReport
+	maxRetries    int
+	depth         int
+	maxDepth      int
+	maxLength     int
+	deterministic bool
+}
+
+// nested returns the settings for the elements of a collection generated under g.
+func (g generation) nested() generation {
+	g.depth++
+	return g
+}
+
+// tooDeep reports whether a collection generated under g lies past the maximum nesting
+// depth and must therefore be generated empty.
+func (g generation) tooDeep() bool {
+	return g.maxDepth > 0 && g.depth >= g.maxDepth
+}
+
+// checkLength reports whether a collection configured with a length of n fits the
+// maximum collection length of g. When it does not, a CollectionTooLargeError is
+// recorded in the report of g and the collection must not be allocated. what names the
+// configured quantity, such as "slice length".
+func (g generation) checkLength(what string, n int) bool {
+	if g.maxLength > 0 && n > g.maxLength {
+		g.report.fail(CollectionTooLargeError{Collection: what, Length: n, Max: g.maxLength})
+		return false
+	}
+	return true
+}
+
+// generationReport records what happened while generating one top-level value.
+// Generators that fail fall back to a zero value and record the failure here, so that
+// GenerateValue can report it, and truncated notes that the element budget cut the value
+// short. A nil report discards everything.
+type generationReport struct {
+	err       error
+	truncated bool
+}
+
+// fail records err unless an earlier failure was recorded already.
+func (r *generationReport) fail(err error) {
+	if r != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+// truncate notes that the element budget ran out before the value was complete.
+func (r *generationReport) truncate() {
+	if r != nil {
+		r.truncated = true
+	}
+}
+
+// elementBudget counts the leaf values that may still be produced while generating
+// one top-level value, noting in report when it runs out. A nil budget is unlimited.
+type elementBudget struct {
+	remaining int
+	report    *generationReport
+}
+
+// take consumes one unit of the budget and reports whether one was available.
+func (b *elementBudget) take() bool {
+	if b == nil {
+		return true
+	}
+	if b.remaining <= 0 {
+		b.report.truncate()
+		return false
+	}
+	b.remaining--
+	return true
+}
+
+// exhausted reports whether the budget has been fully spent. Generators ask before
+// producing another element, so a spent budget means the value is truncated.
+func (b *elementBudget) exhausted() bool {
+	if b == nil || b.remaining > 0 {
+		return false
+	}
+	b.report.truncate()
+	return true
+}
+
+// compositeAttributes is implemented by attributes that generate their value from nested
+// attributes and pass the generation settings on to them.
+type compositeAttributes interface {
+	withGeneration(g generation) Attributes
+}
+
+// constrainedAttributes is implemented by leaf attributes that resample until a
+// constraint holds. withRetries returns a copy of the attributes that gives up after the
+// retry limit of g and records the failure in the report of g.
+type constrainedAttributes interface {
+	withRetries(g generation) Attributes
+}
+
+// bindGeneration attaches g to an attribute specification: composite attributes are
+// rebound recursively, constrained leaves receive the retry limit and report and, when g
+// has a budget, leaves are wrapped so that every value they produce is counted.
+// Specifications that are not Attributes are returned unchanged.
+func bindGeneration(spec any, g generation) any {
+	switch v := spec.(type) {
+	case compositeAttributes:
+		return v.withGeneration(g)
+	case Attributes:
+		if c, ok := v.(constrainedAttributes); ok {
+			v = c.withRetries(g)
+		}
+		if g.budget == nil {
+			return v
+		}
+		return countedAttributes{Attributes: v, budget: g.budget}
+	}
+	return spec
+}
+
+// countedAttributes wraps leaf attributes so that each generated value consumes one
+// unit of the budget. Once the budget is spent it returns nil, which composite
+// attributes replace with the zero value of the element type.
+type countedAttributes struct {
+	Attributes
+	budget *elementBudget
+}
+
+func (c countedAttributes) GetRandomValue() any {
+	if !c.budget.take() {
+		return nil
+	}
+	return c.Attributes.GetRandomValue()
+}
+
+// rootAttributes binds the generation settings of an FTAttributes to attrs afresh for
+// every top-level value, so that each value gets its own element budget and report.
+// maxElements is the element budget, or 0 for none.
+type rootAttributes struct {
+	attrs       Attributes
+	settings    generation
+	maxElements int
+}
+
+func (r rootAttributes) GetAttributes() any           { return r.bind(r.settings).GetAttributes() }
+func (r rootAttributes) GetReflectType() reflect.Type { return r.bind(r.settings).GetReflectType() }
+func (r rootAttributes) GetDefaultImplementation() Attributes {
+	return r.bind(r.settings).GetDefaultImplementation()
+}
+func (r rootAttributes) GetRandomValue() any { return r.generate(nil) }
+
+// bind returns attrs bound to g.
+func (r rootAttributes) bind(g generation) Attributes {
+	return bindGeneration(r.attrs, g).(Attributes)
+}
+
+// generate generates one top-level value, recording failures in report.
+func (r rootAttributes) generate(report *generationReport) any {
+	return r.withGeneration(generation{report: report}).GetRandomValue()
+}
+
+// withGeneration binds the settings of the root to attrs with a fresh element budget,
+// recording failures in the report of g. Roots nested in other attributes, such as the
+// implementation of an interface parameter, are bound this way.
+func (r rootAttributes) withGeneration(g generation) Attributes {
+	settings := r.settings
+	settings.report = g.report
+	if r.maxElements > 0 {
+		settings.budget = &elementBudget{remaining: r.maxElements, report: g.report}
+	}
+	return r.bind(settings)
+}
+
+// withGenerationSettings applies the generation settings of mt to attrs. When a positive
+// MaxRetries, MaxNestingDepth or MaxCollectionLength, or Deterministic, is set, attrs is
+// wrapped in a rootAttributes binding them into every nested generator, together with an
+// element budget for composite attributes when MaxGeneratedElements is positive.
+// Attributes are returned unchanged when none of these settings is used.
+func (mt FTAttributes) withGenerationSettings(attrs Attributes) Attributes {
+	cfg := mt.Generation
+	root := rootAttributes{
+		attrs:    attrs,
+		settings: generation{maxRetries: cfg.MaxRetries, maxDepth: cfg.MaxNestingDepth, maxLength: cfg.MaxCollectionLength, deterministic: mt.Deterministic},
+	}
+	if _, ok := attrs.(compositeAttributes); ok {
+		root.maxElements = max(mt.MaxGeneratedElements, 0)
+	}
+	if root.maxElements > 0 || cfg.MaxRetries > 0 || cfg.MaxNestingDepth > 0 || cfg.MaxCollectionLength > 0 || mt.Deterministic {
+		return root
+	}
+	return attrs
+}
+
+// retryLimit returns n, or defaultMaxRetries when n is not positive.
+func retryLimit(n int) int {
+	if n > 0 {
+		return n
+	}
+	return defaultMaxRetries
+}
+
+// generateUntil calls gen until accept reports true for its value and returns that
+// value. After the retry limit of g (defaultMaxRetries when 0) is spent it records a
+// ConstraintUnsatisfiableError naming the constraint returned by describe in the report
+// of g and returns false, upon which callers fall back to a zero value.
+func (g generation) generateUntil(describe func() string, accept func(any) bool, gen func() any) (any, bool) {
+	attempts := retryLimit(g.maxRetries)
+	for range attempts {
+		if v := gen(); accept(v) {
+			return v, true
+		}
+	}
+	g.report.fail(ConstraintUnsatisfiableError{Constraint: describe(), Attempts: attempts})
+	return nil, false
+}
+
+// drawSatisfying draws a value from attrs, resampling until it satisfies every predicate
+// in preds. field names the attribute field holding preds in the error recorded when the
+// retry budget of g runs out, in which case nil is returned.
+func drawSatisfying(attrs Attributes, field string, preds []p.Predicate, g generation) any {
+	if len(preds) == 0 {
+		return attrs.GetRandomValue()
+	}
+	v, _ := g.generateUntil(
+		func() string { return fmt.Sprintf("%s %v", field, preds) },
+		func(v any) bool { return satisfiesAll(v, preds) },
+		attrs.GetRandomValue,
+	)
+	return v
+}
+
+// satisfiesAll reports whether val satisfies every predicate in preds.
+func satisfiesAll(val any, preds []p.Predicate) bool {
+	for _, pred := range preds {
+		if !pred.Verify(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateValue returns attrs.GetRandomValue(), reporting a constrained generator that
+// ran out of retries as a ConstraintUnsatisfiableError, and a collection configured
+// beyond GenerationConfig.MaxCollectionLength as a CollectionTooLargeError, where
+// GetRandomValue falls back to a zero value. It is the entry point used by FTesting to
+// generate function inputs.
+//
+// Parameters:
+//   - attrs: The attributes to generate a value with
+//
+// Returns:
+//   - value: The generated value (nil on error)
+//   - err: ConstraintUnsatisfiableError when a constraint could not be satisfied, or
+//     CollectionTooLargeError when a collection exceeds the maximum collection length
+//
+// Example usage:
+//
+//	attrs := StringAttributes{MinLen: 5, MaxLen: 5, AllowedRunes: []rune("ab"), UniqueChars: true}
+//	_, err := GenerateValue(attrs) // ConstraintUnsatisfiableError: only two distinct runes
+func GenerateValue(attrs Attributes) (any, error) {
+	value, _, err := GenerateValueReport(attrs)
+	return value, err
+}
+
+// GenerationReport describes how a value returned by GenerateValueReport was generated.
+//
+// Fields:
+//   - Truncated: Whether the value was cut short by FTAttributes.MaxGeneratedElements:
+//     collections were truncated, or array elements and struct fields left at their
+//     zero value, once the element budget ran out
+type GenerationReport struct {
+	Truncated bool
+}
+
+// GenerateValueReport is GenerateValue, returning in addition a GenerationReport of the
+// value, so that callers can tell values cut short by MaxGeneratedElements from values
+// that are small by chance.
+//
+// Parameters:
+//   - attrs: The attributes to generate a value with
+//
+// Returns:
+//   - value: The generated value (nil on error)
+//   - report: How the value was generated
+//   - err: The error GenerateValue would return
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes()
+//	attrs.MaxGeneratedElements = 10
+//	sliceAttrs, _ := attrs.GetAttributeGivenType(reflect.TypeFor[[][]int]())
+//	v, report, _ := GenerateValueReport(sliceAttrs)
+//	if report.Truncated {
+//	    // v holds at most 10 ints
+//	}
+func GenerateValueReport(attrs Attributes) (value any, report GenerationReport, err error) {
+	root, ok := attrs.(rootAttributes)
+	if !ok {
+		root = rootAttributes{attrs: attrs}
+	}
+	r := &generationReport{}
+	value = root.generate(r)
+	if r.err != nil {
+		return nil, GenerationReport{}, r.err
+	}
+	return value, GenerationReport{Truncated: r.truncated}, nil
+}
+
+// RandomValue generates a single random value of type t outside the fuzz loop, such as
+// for seeding test fixtures. It looks up the attributes for t with
+// attrs.GetAttributeGivenType, generates one value with GenerateValue and converts it
+// to t, provided the conversion loses nothing: numbers must be of the same family as t
+// and fit it, so that floats are not truncated to integers nor large ints wrapped.
+//
+// Parameters:
+//   - t: The type of the value to generate
+//   - attrs: The attributes to look the type up in, such as NewFTAttributes()
+//
+// Returns:
+//   - any: The generated value, of exactly type t (the zero value of t when the
+//     attributes generate nil)
+//   - error: The error of GetAttributeGivenType, a ConstraintUnsatisfiableError, or a
+//     TypeMismatchError when the generated value cannot be converted to t losslessly
+//
+// Example usage:
+//
+//	type Celsius float64
+//	v, err := RandomValue(reflect.TypeFor[Celsius](), NewFTAttributes())
+//	temperature := v.(Celsius)
+func RandomValue(t reflect.Type, attrs FTAttributes) (any, error) {
+	a, err := attrs.GetAttributeGivenType(t)
+	if err != nil {
+		return nil, err
+	}
+	value, err := GenerateValue(a)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(value)
+	switch {
+	case !v.IsValid():
+		return reflect.Zero(t).Interface(), nil
+	case v.Type() == t:
+		return value, nil
+	case utils.ConvertsLosslessly(v, t):
+		return v.Convert(t).Interface(), nil
+	}
+	return nil, TypeMismatchError{Want: t, Got: v.Type()}
+}
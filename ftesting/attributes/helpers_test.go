@@ -43,3 +43,15 @@ func (n nilTypeReturningAttribute) GetReflectType() reflect.Type {
 }
 func (n nilTypeReturningAttribute) GetRandomValue() any                  { return nil }
 func (n nilTypeReturningAttribute) GetDefaultImplementation() Attributes { return n }
+
+// evenInt is a predicate satisfied by even ints
+type evenInt struct{}
+
+func (evenInt) Verify(v any) bool { return v.(int)%2 == 0 }
+func (evenInt) String() string    { return "evenInt" }
+
+// neverSatisfied is a predicate that rejects every value
+type neverSatisfied struct{}
+
+func (neverSatisfied) Verify(any) bool { return false }
+func (neverSatisfied) String() string  { return "never" }
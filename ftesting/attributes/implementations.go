@@ -0,0 +1,86 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// ImplementationsAttributes generates values of an interface type from a set of
+// implementations, so that functions taking interfaces such as io.Writer or a domain
+// Store receive a different concrete type from one iteration to the next. Every value is
+// generated by one of Impls, chosen uniformly at random.
+//
+// Fields:
+//   - Interface: The interface type the generated values are used as
+//   - Impls: The attributes generating the implementations; the type each of them
+//     generates must implement Interface
+//
+// Register the attributes for the interface type with FTAttributes.RegisterType, or use
+// PBTest.WithInterfaceImplementations. Unlike StructAttr and PointerAttr, which supply a
+// single implementation, any number of implementations may be mixed.
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes()
+//	attrs.RegisterType(reflect.TypeFor[io.Writer](), ImplementationsAttributes{
+//	    Interface: reflect.TypeFor[io.Writer](),
+//	    Impls:     []Attributes{bufferAttributes{}, PointerAttributes{Depth: 1, Inner: StructAttributes{TargetType: reflect.TypeOf(countingWriter{})}}},
+//	})
+type ImplementationsAttributes struct {
+	Interface reflect.Type
+	Impls     []Attributes
+}
+
+func (a ImplementationsAttributes) GetAttributes() any           { return a }
+func (a ImplementationsAttributes) GetReflectType() reflect.Type { return a.Interface }
+func (a ImplementationsAttributes) GetDefaultImplementation() Attributes {
+	return ImplementationsAttributes{}
+}
+
+func (a ImplementationsAttributes) GetRandomValue() any {
+	if len(a.Impls) == 0 {
+		return nil
+	}
+	return a.Impls[rng.Intn(len(a.Impls))].GetRandomValue()
+}
+
+// withGeneration passes the generation settings on to every implementation.
+func (a ImplementationsAttributes) withGeneration(g generation) Attributes {
+	impls := slices.Clone(a.Impls)
+	for i, impl := range impls {
+		impls[i] = bindGeneration(impl, g).(Attributes)
+	}
+	a.Impls = impls
+	return a
+}
+
+// Validate reports an InvalidAttributeError when Interface is not an interface type,
+// Impls is empty or holds nil attributes, or an implementation generates a type that
+// does not implement Interface, and returns the error of the first invalid
+// implementation.
+func (a ImplementationsAttributes) Validate() error {
+	invalid := func(reason string) error {
+		return InvalidAttributeError{Attribute: "ImplementationsAttributes", Reason: reason}
+	}
+	if a.Interface == nil || a.Interface.Kind() != reflect.Interface {
+		return invalid(fmt.Sprintf("Interface must be an interface type, got %v", a.Interface))
+	}
+	if len(a.Impls) == 0 {
+		return invalid("at least one implementation is required")
+	}
+	for i, impl := range a.Impls {
+		if impl == nil {
+			return invalid(fmt.Sprintf("implementation %d is nil", i))
+		}
+		if t := impl.GetReflectType(); t == nil || !t.Implements(a.Interface) {
+			return invalid(fmt.Sprintf("implementation %d generates %v, which does not implement %v", i, t, a.Interface))
+		}
+		if v, ok := impl.(Validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package attributes
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type shape interface{ Area() int }
+
+type square struct{ Side int }
+
+func (s square) Area() int { return s.Side * s.Side }
+
+type rect struct{ W, H int }
+
+func (r *rect) Area() int { return r.W * r.H }
+
+func TestImplementationsAttributes(t *testing.T) {
+	shapeType := reflect.TypeFor[shape]()
+	impls := ImplementationsAttributes{Interface: shapeType, Impls: []Attributes{
+		StructAttributes{TargetType: reflect.TypeOf(square{})},
+		PointerAttributes{Depth: 1, Inner: StructAttributes{TargetType: reflect.TypeOf(rect{})}},
+	}}
+	attrs := NewFTAttributes()
+	attrs.RegisterType(shapeType, impls)
+	got, err := attrs.GetAttributeGivenType(shapeType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	seen := map[string]bool{}
+	for range 100 {
+		s, ok := got.GetRandomValue().(shape)
+		if !ok {
+			t.Fatalf("expected a shape, got %#v", s)
+		}
+		seen[fmt.Sprintf("%T", s)] = true
+	}
+	if !seen["attributes.square"] || !seen["*attributes.rect"] {
+		t.Errorf("expected both implementations to be generated, got %v", seen)
+	}
+}
+
+func TestImplementationsAttributes_Validate(t *testing.T) {
+	shapeType := reflect.TypeFor[shape]()
+	var iae InvalidAttributeError
+	for _, attrs := range []ImplementationsAttributes{
+		{Impls: []Attributes{StructAttributes{TargetType: reflect.TypeOf(square{})}}},
+		{Interface: reflect.TypeOf(square{}), Impls: []Attributes{StructAttributes{TargetType: reflect.TypeOf(square{})}}},
+		{Interface: shapeType},
+		{Interface: shapeType, Impls: []Attributes{nil}},
+		{Interface: shapeType, Impls: []Attributes{StructAttributes{TargetType: reflect.TypeOf(rect{})}}},
+		{Interface: shapeType, Impls: []Attributes{IntegerAttributesImpl[int]{Min: 0, Max: 10}}},
+	} {
+		if err := attrs.Validate(); !errors.As(err, &iae) {
+			t.Errorf("expected InvalidAttributeError for %+v, got %v", attrs, err)
+		}
+	}
+	attrs := NewFTAttributes()
+	attrs.RegisterType(shapeType, ImplementationsAttributes{Interface: shapeType})
+	if _, err := attrs.GetAttributeGivenType(shapeType); err == nil {
+		t.Error("expected the registered implementations to be validated")
+	}
+}
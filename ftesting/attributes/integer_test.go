@@ -7,6 +7,24 @@ import (
 	ctesting "github.com/laiambryant/gotestutils/ctesting"
 )
 
+func TestIntegerAttributes_NegativeRange(t *testing.T) {
+	attrs := NewFTAttributes(WithIntegerRange(-10, -1))
+	seen := map[int]bool{}
+	for range 500 {
+		v := attrs.IntegerAttr.GetRandomValue().(int)
+		if v < -10 || v > -1 {
+			t.Fatalf("expected integers in [-10, -1], got %d", v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != 10 {
+		t.Errorf("expected every value in [-10, -1] to be generated, got %v", seen)
+	}
+	if v := (IntegerAttributesImpl[int8]{Min: -3, Max: -3}).GetRandomValue(); v != int8(-3) {
+		t.Errorf("expected -3 from a single-value negative range, got %v", v)
+	}
+}
+
 func TestIntegerAttributes(t *testing.T) {
 	var suite []ctesting.CharacterizationTest[bool]
 
@@ -34,8 +52,8 @@ func TestIntegerAttributes(t *testing.T) {
 	}))
 	suite = append(suite, ctesting.NewCharacterizationTest(true, nil, func() (bool, error) {
 		attr := IntegerAttributesImpl[int]{Max: 0, Min: -10}
-		result := attr.GetRandomValue()
-		return result == 0, nil
+		result := attr.GetRandomValue().(int)
+		return result >= -10 && result <= 0, nil
 	}))
 	suite = append(suite, ctesting.NewCharacterizationTest(true, nil, func() (bool, error) {
 		attr := IntegerAttributesImpl[int]{Max: 5, Min: 10}
@@ -29,6 +29,18 @@ type Attributes interface {
 	GetRandomValue() any
 }
 
+// Validator is an optional interface implemented by attributes whose configuration
+// can be rejected up front. FTAttributes.GetAttributeGivenType calls Validate on any
+// attribute that implements it and returns the error instead of generating values.
+//
+// Example usage:
+//
+//	attrs := FloatAttributesImpl[float64]{Min: -1, Max: 1e6, LogScale: true}
+//	err := attrs.Validate() // InvalidAttributeError: LogScale requires Min > 0
+type Validator interface {
+	Validate() error
+}
+
 // AttributesStruct is the interface for the top-level attributes configuration.
 // It maps Go types to their corresponding Attributes implementations.
 //
@@ -0,0 +1,161 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/laiambryant/gotestutils/utils"
+)
+
+// Interval pair layouts accepted by IntervalAttributes.Pair.
+const (
+	IntervalOverlapping = "overlapping"
+	IntervalAdjacent    = "adjacent"
+	IntervalDisjoint    = "disjoint"
+)
+
+// IntervalAttributes generates ordered intervals, so that functions over correlated
+// inputs such as (start, end int) with start <= end receive valid inputs on every
+// iteration instead of wasting most of them on independently drawn endpoints. It suits
+// interval arithmetic, scheduling and range-merge code.
+//
+// Fields:
+//   - Bounds: The attributes generating the endpoints; their values must be ordered
+//     (integers, floats or strings)
+//   - Pair: Empty for a single interval, or IntervalOverlapping, IntervalAdjacent or
+//     IntervalDisjoint for two intervals laid out that way
+//
+// A single interval is generated as a [2]T holding start <= end, where T is the type of
+// Bounds. A pair is generated as a [4]T holding start1, end1, start2, end2 with both
+// intervals ordered and listed in random order: overlapping intervals share at least one
+// point (crossing or nested), adjacent intervals share exactly an endpoint, and disjoint
+// intervals are separated by a gap. Disjoint pairs redraw endpoints that coincide, up to
+// the retry limit of GenerationConfig.MaxRetries, after which GetRandomValue returns the
+// zero array and GenerateValue fails with a ConstraintUnsatisfiableError.
+//
+// Register the attributes for the array type with TypeAttrs, or spread the endpoints
+// over separate parameters with FTesting.WithArgGroup.
+//
+// Example usage:
+//
+//	attrs := IntervalAttributes{Bounds: IntegerAttributesImpl[int]{Min: 0, Max: 100}, Pair: IntervalOverlapping}
+//	v := attrs.GetRandomValue().([4]int) // e.g. [10 40 25 90]
+type IntervalAttributes struct {
+	Bounds Attributes
+	Pair   string
+
+	gen generation
+}
+
+func (a IntervalAttributes) GetAttributes() any { return a }
+func (a IntervalAttributes) GetReflectType() reflect.Type {
+	if a.Bounds == nil || a.Bounds.GetReflectType() == nil {
+		return nil
+	}
+	return reflect.ArrayOf(a.endpoints(), a.Bounds.GetReflectType())
+}
+func (a IntervalAttributes) GetDefaultImplementation() Attributes {
+	return IntervalAttributes{Bounds: IntegerAttributesImpl[int]{Min: -100, Max: 100}}
+}
+
+func (a IntervalAttributes) GetRandomValue() any {
+	t := a.GetReflectType()
+	if t == nil {
+		return nil
+	}
+	var points []any
+	switch a.Pair {
+	case IntervalOverlapping:
+		s := a.drawSorted(4)
+		if rng.Intn(2) == 0 {
+			points = []any{s[0], s[2], s[1], s[3]}
+		} else {
+			points = []any{s[0], s[3], s[1], s[2]}
+		}
+	case IntervalAdjacent:
+		s := a.drawSorted(3)
+		points = []any{s[0], s[1], s[1], s[2]}
+	case IntervalDisjoint:
+		drawn, ok := a.gen.generateUntil(
+			func() string { return "disjoint intervals" },
+			func(v any) bool { return utils.Less(v.([]any)[1], v.([]any)[2]) },
+			func() any { return a.drawSorted(4) },
+		)
+		if !ok {
+			return reflect.Zero(t).Interface()
+		}
+		points = drawn.([]any)
+	default:
+		points = a.drawSorted(2)
+	}
+	if len(points) == 4 && rng.Intn(2) == 0 {
+		points = []any{points[2], points[3], points[0], points[1]}
+	}
+	result := reflect.New(t).Elem()
+	for i, p := range points {
+		result.Index(i).Set(reflect.ValueOf(p))
+	}
+	return result.Interface()
+}
+
+// withRetries returns a copy of the attributes that gives up after the retry limit of g
+func (a IntervalAttributes) withRetries(g generation) Attributes {
+	a.gen = g
+	return a
+}
+
+// endpoints returns the number of endpoints in a generated value.
+func (a IntervalAttributes) endpoints() int {
+	if a.Pair == "" {
+		return 2
+	}
+	return 4
+}
+
+// drawSorted draws n endpoints from Bounds, converted to its reflect type, in ascending
+// order.
+func (a IntervalAttributes) drawSorted(n int) []any {
+	t := a.Bounds.GetReflectType()
+	points := make([]any, n)
+	for i := range points {
+		points[i] = reflect.ValueOf(a.Bounds.GetRandomValue()).Convert(t).Interface()
+	}
+	slices.SortFunc(points, func(x, y any) int {
+		switch {
+		case utils.Less(x, y):
+			return -1
+		case utils.Less(y, x):
+			return 1
+		}
+		return 0
+	})
+	return points
+}
+
+// Validate reports an InvalidAttributeError when Bounds is missing or generates values
+// that are not ordered, Pair is unknown, or disjoint intervals are requested from an
+// integer range holding a single value.
+func (a IntervalAttributes) Validate() error {
+	invalid := func(reason string) error {
+		return InvalidAttributeError{Attribute: "IntervalAttributes", Reason: reason}
+	}
+	if a.Bounds == nil {
+		return invalid("Bounds must be set")
+	}
+	if t := a.Bounds.GetReflectType(); t == nil || !utils.IsOrdered(t) {
+		return invalid(fmt.Sprintf("Bounds generate %v, which is not ordered", t))
+	}
+	switch a.Pair {
+	case "", IntervalOverlapping, IntervalAdjacent:
+	case IntervalDisjoint:
+		if r, ok := a.Bounds.(integerRange); ok {
+			if lo, hi, ok := r.intRange(); ok && lo == hi {
+				return invalid("disjoint intervals need at least two distinct endpoints")
+			}
+		}
+	default:
+		return invalid(fmt.Sprintf("unknown pair layout %q, expected %q, %q or %q", a.Pair, IntervalOverlapping, IntervalAdjacent, IntervalDisjoint))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package attributes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestIntervalAttributes(t *testing.T) {
+	bounds := IntegerAttributesImpl[int]{Min: 0, Max: 100}
+	single := IntervalAttributes{Bounds: bounds}
+	if single.GetReflectType() != reflect.TypeOf([2]int{}) {
+		t.Fatalf("expected [2]int, got %v", single.GetReflectType())
+	}
+	for range 200 {
+		if v := single.GetRandomValue().([2]int); v[0] > v[1] || v[0] < 0 || v[1] > 100 {
+			t.Fatalf("expected an ordered interval within the bounds, got %v", v)
+		}
+	}
+	for _, tc := range []struct {
+		pair  string
+		holds func(s1, e1, s2, e2 int) bool
+	}{
+		{IntervalOverlapping, func(s1, e1, s2, e2 int) bool { return max(s1, s2) <= min(e1, e2) }},
+		{IntervalAdjacent, func(s1, e1, s2, e2 int) bool { return e1 == s2 || e2 == s1 }},
+		{IntervalDisjoint, func(s1, e1, s2, e2 int) bool { return e1 < s2 || e2 < s1 }},
+	} {
+		attrs := IntervalAttributes{Bounds: bounds, Pair: tc.pair}
+		if attrs.GetReflectType() != reflect.TypeOf([4]int{}) {
+			t.Fatalf("expected [4]int for %s pairs, got %v", tc.pair, attrs.GetReflectType())
+		}
+		for range 200 {
+			v := attrs.GetRandomValue().([4]int)
+			if v[0] > v[1] || v[2] > v[3] || !tc.holds(v[0], v[1], v[2], v[3]) {
+				t.Fatalf("expected %s ordered intervals, got %v", tc.pair, v)
+			}
+		}
+	}
+	words := IntervalAttributes{Bounds: StringAttributes{MinLen: 1, MaxLen: 3}}
+	if v := words.GetRandomValue().([2]string); v[0] > v[1] {
+		t.Errorf("expected ordered strings, got %q", v)
+	}
+	floats := IntervalAttributes{Bounds: FloatAttributesImpl[float32]{Min: 0, Max: 1}}
+	if v := floats.GetRandomValue().([2]float64); v[0] > v[1] {
+		t.Errorf("expected ordered floats, got %v", v)
+	}
+}
+
+func TestIntervalAttributes_Validate(t *testing.T) {
+	var iae InvalidAttributeError
+	for _, attrs := range []IntervalAttributes{
+		{},
+		{Bounds: BoolAttributes{}},
+		{Bounds: IntegerAttributesImpl[int]{Min: 0, Max: 9}, Pair: "nested"},
+		{Bounds: IntegerAttributesImpl[int]{Min: 4, Max: 4}, Pair: IntervalDisjoint},
+	} {
+		if err := attrs.Validate(); !errors.As(err, &iae) {
+			t.Errorf("expected InvalidAttributeError for %+v, got %v", attrs, err)
+		}
+	}
+	if err := (IntervalAttributes{Bounds: IntegerAttributesImpl[int]{Min: 4, Max: 4}, Pair: IntervalAdjacent}).Validate(); err != nil {
+		t.Errorf("expected adjacent intervals of a single value to be valid, got %v", err)
+	}
+	attrs := IntervalAttributes{Bounds: StringAttributes{MinLen: 1, MaxLen: 1, AllowedRunes: []rune("a")}, Pair: IntervalDisjoint}
+	if v := attrs.GetRandomValue(); v != [4]string{} {
+		t.Errorf("expected the zero array once the retry budget is spent, got %q", v)
+	}
+	var cue ConstraintUnsatisfiableError
+	if _, err := GenerateValue(attrs); !errors.As(err, &cue) || cue.Constraint != "disjoint intervals" {
+		t.Errorf("expected ConstraintUnsatisfiableError, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package attributes
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// jsonShape returns the nesting depth of v and the largest array or object size in it,
+// failing the test on values encoding/json could not have produced
+func jsonShape(t *testing.T, v any) (depth, breadth int) {
+	t.Helper()
+	switch val := v.(type) {
+	case nil, bool, float64, string:
+		return 0, 0
+	case []any:
+		breadth = len(val)
+		for _, e := range val {
+			d, b := jsonShape(t, e)
+			depth, breadth = max(depth, d+1), max(breadth, b)
+		}
+		return max(depth, 1), breadth
+	case map[string]any:
+		breadth = len(val)
+		for _, e := range val {
+			d, b := jsonShape(t, e)
+			depth, breadth = max(depth, d+1), max(breadth, b)
+		}
+		return max(depth, 1), breadth
+	}
+	t.Fatalf("unexpected JSON value of type %T", v)
+	return 0, 0
+}
+
+func TestJSONAttributes(t *testing.T) {
+	attrs := JSONAttributes{MaxDepth: 2, MaxBreadth: 3}
+	sawContainer := false
+	for range 200 {
+		v := attrs.GetRandomValue()
+		depth, breadth := jsonShape(t, v)
+		if depth > 2 || breadth > 3 {
+			t.Fatalf("expected depth <= 2 and breadth <= 3, got %d and %d for %v", depth, breadth, v)
+		}
+		sawContainer = sawContainer || depth > 0
+		if _, err := json.Marshal(v); err != nil {
+			t.Fatalf("expected a JSON-encodable value, got %v: %v", v, err)
+		}
+	}
+	if !sawContainer {
+		t.Error("expected arrays or objects to be generated")
+	}
+	if attrs.GetReflectType() != reflect.TypeOf((*any)(nil)).Elem() {
+		t.Errorf("expected the any type, got %v", attrs.GetReflectType())
+	}
+}
+
+func TestGetAttributeGivenType_Any(t *testing.T) {
+	got, err := FTAttributes{}.GetAttributeGivenType(reflect.TypeOf((*any)(nil)).Elem())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, JSONAttributes{}.GetDefaultImplementation()) {
+		t.Errorf("expected default JSONAttributes for any, got %#v", got)
+	}
+	custom := NewFTAttributes().With(reflect.Interface, JSONAttributes{MaxDepth: 1, MaxBreadth: 1})
+	got, _ = custom.GetAttributeGivenType(reflect.TypeOf((*any)(nil)).Elem())
+	if !reflect.DeepEqual(got, JSONAttributes{MaxDepth: 1, MaxBreadth: 1}) {
+		t.Errorf("expected the configured JSONAttributes, got %#v", got)
+	}
+}
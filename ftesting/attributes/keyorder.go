@@ -0,0 +1,92 @@
+package attributes
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// keyOrderCapacity is the number of generated maps whose key order a KeyOrders remembers.
+// The registry holds a reference to every map it remembers, so the oldest entries are
+// evicted to bound memory; a remembered map can therefore never be confused with another
+// one allocated at the same address.
+const keyOrderCapacity = 1024
+
+type recordedKeyOrder struct {
+	m    reflect.Value
+	keys []any
+}
+
+// KeyOrders records the insertion order of the maps generated by MapAttributes whose
+// RecordKeyOrder points to it. Go randomizes map iteration, so a function that ranges over
+// a generated map can behave differently on every run even when the inputs are reproduced
+// with Seed; iterating the keys returned by KeyOrder instead makes the behaviour
+// reproducible too. The zero value is ready to use, and a KeyOrders is safe for concurrent
+// use.
+//
+// Only the maps recorded in the registry are known to it, so the recorded orders live
+// exactly as long as the registry: create one per test run rather than sharing one across
+// runs. Only the most recently generated maps are remembered (currently 1024).
+//
+// Example usage:
+//
+//	orders := &KeyOrders{}
+//	attrs := MapAttributes{MinSize: 1, MaxSize: 5, KeyAttrs: StringAttributes{MinLen: 1, MaxLen: 3},
+//	    ValueAttrs: IntegerAttributesImpl[int]{Min: 0, Max: 9}, RecordKeyOrder: orders}
+//	m := attrs.GetRandomValue().(map[string]int)
+//	keys, _ := orders.KeyOrder(m) // e.g. []any{"ab", "x", "qrs"}
+type KeyOrders struct {
+	mu     sync.Mutex
+	orders map[uintptr]recordedKeyOrder
+	fifo   []uintptr
+}
+
+// record remembers keys as the insertion order of the map m, evicting the oldest
+// remembered map when the registry is full.
+func (r *KeyOrders) record(m reflect.Value, keys []any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.orders == nil {
+		r.orders = map[uintptr]recordedKeyOrder{}
+	}
+	ptr := m.Pointer()
+	if _, ok := r.orders[ptr]; !ok {
+		if len(r.fifo) == keyOrderCapacity {
+			delete(r.orders, r.fifo[0])
+			r.fifo = r.fifo[1:]
+		}
+		r.fifo = append(r.fifo, ptr)
+	}
+	r.orders[ptr] = recordedKeyOrder{m: m, keys: keys}
+}
+
+// KeyOrder returns the keys of a map recorded in the registry, in the order they were
+// inserted. The order reflects the map as generated: keys added or deleted afterwards are
+// not tracked.
+//
+// Parameters:
+//   - m: A map generated by MapAttributes with RecordKeyOrder set to r
+//
+// Returns:
+//   - keys: The keys of m in insertion order
+//   - ok: false for maps evicted from or never recorded in r, and for non-map values
+//
+// Example usage:
+//
+//	keys, _ := orders.KeyOrder(m)
+//	for _, k := range keys {
+//	    process(k, m[k.(string)])
+//	}
+func (r *KeyOrders) KeyOrder(m any) (keys []any, ok bool) {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map || v.IsNil() {
+		return nil, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	order, ok := r.orders[v.Pointer()]
+	if !ok {
+		return nil, false
+	}
+	return slices.Clone(order.keys), true
+}
@@ -0,0 +1,97 @@
+package attributes
+
+import (
+	"fmt"
+	"math"
+)
+
+// Length distribution kinds accepted by LengthDistribution.Kind.
+const (
+	LengthUniform     = "uniform"
+	LengthExponential = "exponential"
+	LengthWeighted    = "weighted"
+)
+
+// LengthDistribution controls how a length is sampled from [MinLen, MaxLen]. Uniform
+// sampling rarely produces the shortest and longest lengths of a wide range, where
+// off-by-one and buffer bugs live; an exponential distribution yields mostly short values
+// with occasional long ones, and weights pin down the frequency of every length.
+//
+// Fields:
+//   - Kind: LengthUniform (the default when empty), LengthExponential or LengthWeighted
+//   - Mean: For LengthExponential, the mean number of elements above MinLen before the
+//     distribution is truncated at MaxLen; 0 uses a quarter of the range, at least 1
+//   - Weights: For LengthWeighted, the relative weight of each length, Weights[i] being
+//     that of MinLen+i; lengths past the end of Weights are never drawn
+//
+// Example usage:
+//
+//	attrs := StringAttributes{MinLen: 0, MaxLen: 1000, LengthDistribution: LengthDistribution{Kind: LengthExponential, Mean: 5}}
+//	attrs = StringAttributes{MinLen: 0, MaxLen: 2, LengthDistribution: LengthDistribution{Kind: LengthWeighted, Weights: []float64{1, 1, 8}}}
+type LengthDistribution struct {
+	Kind    string
+	Mean    float64
+	Weights []float64
+}
+
+// pick draws a length in [minLen, maxLen] from the distribution.
+func (d LengthDistribution) pick(minLen, maxLen int) int {
+	if maxLen <= minLen {
+		return minLen
+	}
+	span := maxLen - minLen + 1
+	switch d.Kind {
+	case LengthExponential:
+		mean := d.Mean
+		if mean <= 0 {
+			mean = math.Max(1, float64(span-1)/4)
+		}
+		// Inverse CDF of the exponential distribution truncated to [0, span).
+		u := rng.Float64() * -math.Expm1(-float64(span)/mean)
+		return min(minLen+int(-mean*math.Log1p(-u)), maxLen)
+	case LengthWeighted:
+		weights := d.Weights[:min(len(d.Weights), span)]
+		var total float64
+		for _, w := range weights {
+			total += w
+		}
+		r := rng.Float64() * total
+		for i, w := range weights {
+			if r < w {
+				return minLen + i
+			}
+			r -= w
+		}
+		for i := len(weights) - 1; i >= 0; i-- {
+			if weights[i] > 0 {
+				return minLen + i
+			}
+		}
+	}
+	return minLen + rng.Intn(span)
+}
+
+// validate reports why the distribution cannot sample [minLen, maxLen], or "" when it can.
+func (d LengthDistribution) validate(minLen, maxLen int) string {
+	switch d.Kind {
+	case "", LengthUniform:
+	case LengthExponential:
+		if d.Mean < 0 || math.IsNaN(d.Mean) || math.IsInf(d.Mean, 0) {
+			return "exponential Mean must be finite and non-negative"
+		}
+	case LengthWeighted:
+		positive := false
+		for i, w := range d.Weights {
+			if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+				return "Weights must be finite and non-negative"
+			}
+			positive = positive || (w > 0 && i <= maxLen-minLen)
+		}
+		if !positive {
+			return fmt.Sprintf("Weights give no length in [%d, %d] a positive weight", minLen, maxLen)
+		}
+	default:
+		return fmt.Sprintf("unknown kind %q, expected %q, %q or %q", d.Kind, LengthUniform, LengthExponential, LengthWeighted)
+	}
+	return ""
+}
@@ -3,8 +3,10 @@ package attributes
 import (
 	"reflect"
 	"testing"
+	"unsafe"
 
 	"github.com/laiambryant/gotestutils/ctesting"
+	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
 )
 
 func TestMapAttributes(t *testing.T) {
@@ -218,8 +220,8 @@ func TestGetAttributeGivenType_KindNotInMap(t *testing.T) {
 		typ  reflect.Type
 	}{
 		{"chan", reflect.TypeOf(make(chan int))},
-		{"func", reflect.TypeOf(func() {})},
-		{"interface", reflect.TypeOf((*any)(nil)).Elem()},
+		{"unsafe pointer", reflect.TypeOf(unsafe.Pointer(nil))},
+		{"interface", reflect.TypeOf((*error)(nil)).Elem()},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -284,3 +286,172 @@ func TestMapAttributes_GetReflectType_WithNilValueType(t *testing.T) {
 		t.Errorf("Expected nil reflect type for map with nil value attrs, got %v", reflectType)
 	}
 }
+
+func TestMapAttributes_ReachesMinSizeWithCollidingKeys(t *testing.T) {
+	attrs := MapAttributes{
+		MinSize:    8,
+		MaxSize:    8,
+		KeyAttrs:   IntegerAttributesImpl[int]{Min: 1, Max: 10},
+		ValueAttrs: IntegerAttributesImpl[int]{Min: 0, Max: 100},
+	}
+	if err := attrs.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		m := attrs.GetRandomValue().(map[int]int)
+		if len(m) != 8 {
+			t.Fatalf("expected exactly 8 distinct keys, got %d", len(m))
+		}
+	}
+}
+
+func TestMapAttributes_InsufficientKeyDomain(t *testing.T) {
+	attrs := MapAttributes{
+		MinSize:    5,
+		MaxSize:    5,
+		KeyAttrs:   IntegerAttributesImpl[int]{Min: 1, Max: 3},
+		ValueAttrs: IntegerAttributesImpl[int]{Min: 0, Max: 100},
+	}
+	if _, ok := attrs.Validate().(InvalidAttributeError); !ok {
+		t.Errorf("expected InvalidAttributeError for a 3-key domain with MinSize 5")
+	}
+	if m := attrs.GetRandomValue().(map[int]int); len(m) != 3 {
+		t.Errorf("expected generation to stop at the 3 available keys, got %d", len(m))
+	}
+	boolKeys := MapAttributes{MinSize: 3, MaxSize: 3, KeyAttrs: BoolAttributes{}, ValueAttrs: IntegerAttributesImpl[int]{}}
+	if _, ok := boolKeys.Validate().(InvalidAttributeError); !ok {
+		t.Errorf("expected InvalidAttributeError for bool keys with MinSize 3")
+	}
+	stringKeys := MapAttributes{MinSize: 5, MaxSize: 5, KeyAttrs: StringAttributes{MinLen: 1, MaxLen: 5}, ValueAttrs: IntegerAttributesImpl[int]{}}
+	if err := stringKeys.Validate(); err != nil {
+		t.Errorf("expected no validation error for an unbounded key domain, got %v", err)
+	}
+}
+
+func TestMapAttributes_KeyValuePredsResample(t *testing.T) {
+	attrs := MapAttributes{
+		MinSize: 3, MaxSize: 3,
+		KeyAttrs:   IntegerAttributesImpl[int]{Min: 1, Max: 100},
+		ValueAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 100},
+		KeyPreds:   []p.Predicate{evenInt{}},
+		ValuePreds: []p.Predicate{evenInt{}},
+	}
+	for range 20 {
+		for k, v := range attrs.GetRandomValue().(map[int]int) {
+			if k%2 != 0 || v%2 != 0 {
+				t.Fatalf("expected even keys and values, got %d: %d", k, v)
+			}
+		}
+	}
+}
+
+func TestMapAttributes_UniqueValues(t *testing.T) {
+	attrs := MapAttributes{
+		MinSize: 8, MaxSize: 8,
+		KeyAttrs:     IntegerAttributesImpl[int]{Min: 1, Max: 100},
+		ValueAttrs:   IntegerAttributesImpl[int]{Min: 1, Max: 10},
+		UniqueValues: true,
+	}
+	for range 50 {
+		m := attrs.GetRandomValue().(map[int]int)
+		if len(m) != 8 {
+			t.Fatalf("expected 8 entries, got %d", len(m))
+		}
+		seen := map[int]bool{}
+		for _, v := range m {
+			if seen[v] {
+				t.Fatalf("expected distinct values, got %v", m)
+			}
+			seen[v] = true
+		}
+	}
+}
+
+func TestMapAttributes_UniqueValuesCapsToValueDomain(t *testing.T) {
+	attrs := MapAttributes{
+		MinSize: 5, MaxSize: 5,
+		KeyAttrs:     StringAttributes{MinLen: 3, MaxLen: 8},
+		ValueAttrs:   BoolAttributes{},
+		UniqueValues: true,
+	}
+	if m := attrs.GetRandomValue().(map[string]bool); len(m) != 2 {
+		t.Errorf("expected generation to stop at the 2 available values, got %d", len(m))
+	}
+}
+
+func TestMapAttributes_UniqueValuesUsesMaxRetries(t *testing.T) {
+	attrs := FTAttributes{
+		MapAttr: MapAttributes{
+			MinSize: 5, MaxSize: 5,
+			KeyAttrs:     StringAttributes{MinLen: 8, MaxLen: 8},
+			ValueAttrs:   BoolAttributes{},
+			UniqueValues: true,
+		},
+		Generation: GenerationConfig{MaxRetries: 1},
+	}
+	mapAttr, err := attrs.GetAttributeGivenType(reflect.TypeOf(map[string]bool{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	short := false
+	for range 50 {
+		m := mapAttr.GetRandomValue().(map[string]bool)
+		if len(m) > 2 {
+			t.Fatalf("expected at most the 2 available values, got %v", m)
+		}
+		short = short || len(m) == 1
+	}
+	if !short {
+		t.Error("expected a single value collision to stop generation with MaxRetries 1")
+	}
+}
+
+func TestMapAttributes_RecordKeyOrder(t *testing.T) {
+	orders := &KeyOrders{}
+	attrs := MapAttributes{
+		MinSize: 1, MaxSize: 10,
+		KeyAttrs:       StringAttributes{MinLen: 1, MaxLen: 4},
+		ValueAttrs:     IntegerAttributesImpl[int]{Min: 1, Max: 10},
+		RecordKeyOrder: orders,
+	}
+	Seed(7)
+	first := attrs.GetRandomValue().(map[string]int)
+	firstKeys, ok := orders.KeyOrder(first)
+	if !ok || len(firstKeys) != len(first) {
+		t.Fatalf("expected one recorded key per entry, got %v for %v", firstKeys, first)
+	}
+	for _, k := range firstKeys {
+		if _, ok := first[k.(string)]; !ok {
+			t.Fatalf("expected recorded key %v to be in the map %v", k, first)
+		}
+	}
+	Seed(7)
+	second := attrs.GetRandomValue().(map[string]int)
+	if secondKeys, _ := orders.KeyOrder(second); !reflect.DeepEqual(firstKeys, secondKeys) {
+		t.Errorf("expected the same seed to record the same key order, got %v and %v", firstKeys, secondKeys)
+	}
+	if _, ok := (&KeyOrders{}).KeyOrder(first); ok {
+		t.Error("expected another registry not to know the map")
+	}
+	attrs.RecordKeyOrder = nil
+	for _, v := range []any{attrs.GetRandomValue(), map[string]int{"a": 1}, map[string]int(nil), 3} {
+		if _, ok := orders.KeyOrder(v); ok {
+			t.Errorf("expected no recorded order for %v", v)
+		}
+	}
+}
+
+func TestKeyOrder_EvictsOldestMaps(t *testing.T) {
+	orders := &KeyOrders{}
+	attrs := MapAttributes{MinSize: 1, MaxSize: 1, KeyAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 10}, ValueAttrs: BoolAttributes{}, RecordKeyOrder: orders}
+	oldest := attrs.GetRandomValue()
+	for range keyOrderCapacity {
+		attrs.GetRandomValue()
+	}
+	if _, ok := orders.KeyOrder(oldest); ok {
+		t.Error("expected the oldest map to be evicted once the registry is full")
+	}
+	if keys, ok := orders.KeyOrder(attrs.GetRandomValue()); !ok || len(keys) != 1 {
+		t.Errorf("expected the latest map to be recorded, got %v", keys)
+	}
+}
@@ -241,3 +241,172 @@ func TestPointerAttributes_NilInnerValueWithType(t *testing.T) {
 
 	ctesting.VerifyCharacterizationTestsAndResults(t, testSuite, true)
 }
+
+func TestPointerAttributes_SliceNilSemantics(t *testing.T) {
+	attrs := PointerAttributes{
+		AllowNil:              true,
+		Depth:                 1,
+		Inner:                 SliceAttributes{MinLen: 1, MaxLen: 5, ElementAttrs: IntegerAttributesImpl[int]{Min: 0, Max: 10}},
+		NilProbability:        0.25,
+		NilInnerProbability:   0.25,
+		EmptyInnerProbability: 0.25,
+	}
+	var nilPtr, ptrToNil, ptrToEmpty, ptrToFilled int
+	for i := 0; i < 1000; i++ {
+		p, ok := attrs.GetRandomValue().(*[]int)
+		if !ok {
+			t.Fatalf("expected *[]int, got %T", attrs.GetRandomValue())
+		}
+		switch {
+		case p == nil:
+			nilPtr++
+		case *p == nil:
+			ptrToNil++
+		case len(*p) == 0:
+			ptrToEmpty++
+		default:
+			ptrToFilled++
+		}
+	}
+	if nilPtr == 0 || ptrToNil == 0 || ptrToEmpty == 0 || ptrToFilled == 0 {
+		t.Errorf("expected all shapes, got nil=%d ptrToNil=%d ptrToEmpty=%d filled=%d", nilPtr, ptrToNil, ptrToEmpty, ptrToFilled)
+	}
+}
+
+func TestPointerAttributes_NilProbabilityRequiresAllowNil(t *testing.T) {
+	attrs := PointerAttributes{Depth: 1, Inner: IntegerAttributesImpl[int]{Min: 1, Max: 10}, NilProbability: 1}
+	for i := 0; i < 100; i++ {
+		if p := attrs.GetRandomValue().(*int); p == nil {
+			t.Fatal("expected no nil pointer without AllowNil")
+		}
+	}
+	if _, ok := attrs.Validate().(InvalidAttributeError); !ok {
+		t.Error("expected InvalidAttributeError for NilProbability without AllowNil")
+	}
+	attrs.AllowNil = true
+	if err := attrs.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if attrs.GetRandomValue().(*int) != nil {
+		t.Error("expected a nil pointer with NilProbability 1 and AllowNil")
+	}
+}
+
+func TestPointerAttributes_MapNilSemantics(t *testing.T) {
+	attrs := PointerAttributes{
+		Depth:                 1,
+		Inner:                 reflect.TypeOf(map[string]int{}),
+		NilInnerProbability:   0.5,
+		EmptyInnerProbability: 0.5,
+	}
+	var ptrToNil, ptrToEmpty int
+	for i := 0; i < 200; i++ {
+		p := attrs.GetRandomValue().(*map[string]int)
+		if p == nil {
+			t.Fatal("expected a non-nil pointer when NilProbability is zero")
+		}
+		if *p == nil {
+			ptrToNil++
+		} else if len(*p) == 0 {
+			ptrToEmpty++
+		}
+	}
+	if ptrToNil == 0 || ptrToEmpty == 0 {
+		t.Errorf("expected both shapes, got ptrToNil=%d ptrToEmpty=%d", ptrToNil, ptrToEmpty)
+	}
+}
+
+func TestPointerAttributes_EmptyInnerIgnoredForScalars(t *testing.T) {
+	attrs := PointerAttributes{Depth: 1, Inner: IntegerAttributesImpl[int]{Min: 1, Max: 10}, EmptyInnerProbability: 1}
+	for i := 0; i < 50; i++ {
+		p := attrs.GetRandomValue().(*int)
+		if p == nil || *p < 1 || *p > 10 {
+			t.Fatalf("expected a pointer to a generated int, got %v", p)
+		}
+	}
+}
+
+func TestPointerAttributes_ResolvesNamedStructTarget(t *testing.T) {
+	attrs := NewFTAttributes()
+	attrs.PointerAttr = PointerAttributes{Depth: 2, Inner: StructAttributes{
+		FieldAttrs: map[string]any{"Age": IntegerAttributesImpl[int]{Min: 1, Max: 5}},
+	}}
+	pa, err := attrs.GetAttributeGivenType(reflect.TypeOf((**person)(nil)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pa.GetReflectType() != reflect.TypeOf((**person)(nil)) {
+		t.Errorf("expected **person, got %v", pa.GetReflectType())
+	}
+	if v, ok := pa.GetRandomValue().(**person); ok && v != nil && ((*v).Age < 1 || (*v).Age > 5) {
+		t.Errorf("expected Age to be generated in [1, 5], got %d", (*v).Age)
+	}
+	if untouched := (PointerAttributes{Inner: IntegerAttributesImpl[int]{}}).forType(reflect.TypeOf((*person)(nil))); untouched.Inner != (IntegerAttributesImpl[int]{}) {
+		t.Errorf("expected non-struct inner attributes to be left alone, got %v", untouched.Inner)
+	}
+}
+
+func TestPointerAttributes_RandomDepth(t *testing.T) {
+	attrs := PointerAttributes{MinDepth: 1, MaxDepth: 3, Inner: IntegerAttributesImpl[int]{Min: 1, Max: 10}}
+	if attrs.GetReflectType() != anyType {
+		t.Fatalf("expected a random depth to report any, got %v", attrs.GetReflectType())
+	}
+	seen := map[int]bool{}
+	for range 200 {
+		v := reflect.ValueOf(attrs.GetRandomValue())
+		depth := 0
+		for v.Kind() == reflect.Pointer {
+			v = v.Elem()
+			depth++
+		}
+		if depth < 1 || depth > 3 || v.Int() < 1 || v.Int() > 10 {
+			t.Fatalf("expected 1 to 3 pointer levels to an int in [1, 10], got depth %d", depth)
+		}
+		seen[depth] = true
+	}
+	if len(seen) != 3 {
+		t.Errorf("expected every depth in [1, 3] to be generated, got %v", seen)
+	}
+	if _, ok := (PointerAttributes{MinDepth: 4, MaxDepth: 2}).Validate().(InvalidAttributeError); !ok {
+		t.Error("expected InvalidAttributeError for MinDepth greater than MaxDepth")
+	}
+}
+
+func TestGetAttributeGivenType_AnyWithRandomPointerDepth(t *testing.T) {
+	attrs := NewFTAttributes()
+	if a, _ := attrs.GetAttributeGivenType(anyType); reflect.TypeOf(a) != reflect.TypeOf(JSONAttributes{}) {
+		t.Fatalf("expected any parameters to use JSONAttr by default, got %T", a)
+	}
+	attrs.PointerAttr = PointerAttributes{MaxDepth: 2, Inner: StringAttributes{MinLen: 1, MaxLen: 3}}
+	a, err := attrs.GetAttributeGivenType(anyType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	switch v := a.GetRandomValue().(type) {
+	case *string, **string:
+	default:
+		t.Errorf("expected *string or **string, got %T", v)
+	}
+	attrs.PointerAttr = PointerAttributes{MinDepth: 3, MaxDepth: 1, Inner: StringAttributes{}}
+	if _, err := attrs.GetAttributeGivenType(anyType); err == nil {
+		t.Error("expected the PointerAttr to be validated")
+	}
+}
+
+func TestGetAttributeGivenType_TypedPointerIgnoresRandomDepth(t *testing.T) {
+	attrs := NewFTAttributes()
+	attrs.PointerAttr = PointerAttributes{Depth: 1, MinDepth: 1, MaxDepth: 3, Inner: IntegerAttributesImpl[int]{Min: 1, Max: 10}}
+	a, err := attrs.GetAttributeGivenType(reflect.TypeFor[*int]())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.GetReflectType() != reflect.TypeFor[*int]() {
+		t.Fatalf("expected *int parameters to report *int, got %v", a.GetReflectType())
+	}
+	for range 50 {
+		got := a.GetRandomValue()
+		if v, ok := got.(*int); !ok || v == nil || *v < 1 || *v > 10 {
+			t.Fatalf("expected a *int to an int in [1, 10], got %#v", got)
+		}
+	}
+}
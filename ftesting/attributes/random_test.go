@@ -0,0 +1,29 @@
+package attributes
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSeed(t *testing.T) {
+	Seed(7)
+	first := StringAttributes{MinLen: 20, MaxLen: 20}.GetRandomValue()
+	Seed(7)
+	if second := (StringAttributes{MinLen: 20, MaxLen: 20}).GetRandomValue(); first != second {
+		t.Errorf("expected reseeding to repeat the sequence, got %q and %q", first, second)
+	}
+}
+
+func TestSharedSourceConcurrentUse(t *testing.T) {
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				SliceAttributes{MinLen: 1, MaxLen: 5, ElementAttrs: FloatAttributesImpl[float64]{Min: 0, Max: 1}}.GetRandomValue()
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,78 @@
+package attributes
+
+import (
+	"maps"
+	"reflect"
+	"sync"
+)
+
+var (
+	globalTypesMu sync.RWMutex
+	globalTypes   = map[reflect.Type]Attributes{}
+)
+
+// RegisterType makes GetAttributeGivenType generate values of exactly type t with attrs,
+// ahead of the attributes configured for the kind of t. This is how domain types that
+// the kind-based attributes cannot produce, such as time.Time or a UUID type, are
+// supported. attrs must generate values assignable or convertible to t. Registering nil
+// attributes removes the registration. The registrations are copied before being
+// changed, so copies of mt made earlier are not affected.
+//
+// Parameters:
+//   - t: The exact type to generate
+//   - attrs: The attributes generating its values
+//
+// Example usage:
+//
+//	attrs := NewFTAttributes()
+//	attrs.RegisterType(reflect.TypeOf(time.Time{}), timeAttributes{From: start, To: end})
+func (mt *FTAttributes) RegisterType(t reflect.Type, attrs Attributes) {
+	registered := maps.Clone(mt.TypeAttrs)
+	if registered == nil {
+		registered = map[reflect.Type]Attributes{}
+	}
+	if attrs == nil {
+		delete(registered, t)
+	} else {
+		registered[t] = attrs
+	}
+	mt.TypeAttrs = registered
+}
+
+// RegisterGlobalType registers attrs for type t in the package-level default registry,
+// which NewFTAttributes copies into the TypeAttrs of every FTAttributes it builds. Custom
+// types can therefore be registered once, for example in TestMain, and used by every
+// FTesting and PBTest relying on NewFTAttributes. Registrations made with RegisterType on
+// an FTAttributes override the global ones for that instance, and global registrations
+// made after an FTAttributes was built do not affect it. Registering nil attributes
+// removes the registration. The registry is safe for concurrent use.
+//
+// Parameters:
+//   - t: The exact type to generate
+//   - attrs: The attributes generating its values
+//
+// Example usage:
+//
+//	func TestMain(m *testing.M) {
+//	    attributes.RegisterGlobalType(reflect.TypeOf(uuid.UUID{}), uuidAttributes{})
+//	    os.Exit(m.Run())
+//	}
+func RegisterGlobalType(t reflect.Type, attrs Attributes) {
+	globalTypesMu.Lock()
+	defer globalTypesMu.Unlock()
+	if attrs == nil {
+		delete(globalTypes, t)
+		return
+	}
+	globalTypes[t] = attrs
+}
+
+// globalTypeAttrs returns a copy of the global registry, or nil when it is empty.
+func globalTypeAttrs() map[reflect.Type]Attributes {
+	globalTypesMu.RLock()
+	defer globalTypesMu.RUnlock()
+	if len(globalTypes) == 0 {
+		return nil
+	}
+	return maps.Clone(globalTypes)
+}
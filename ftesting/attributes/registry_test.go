@@ -0,0 +1,88 @@
+package attributes
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// timeAttributes generates times at whole seconds after a fixed instant.
+type timeAttributes struct {
+	From time.Time
+}
+
+func (a timeAttributes) GetAttributes() any                   { return a }
+func (a timeAttributes) GetReflectType() reflect.Type         { return reflect.TypeOf(time.Time{}) }
+func (a timeAttributes) GetDefaultImplementation() Attributes { return a }
+func (a timeAttributes) GetRandomValue() any {
+	return a.From.Add(time.Duration(rng.Intn(3600)) * time.Second)
+}
+
+func TestRegisterType(t *testing.T) {
+	timeType := reflect.TypeOf(time.Time{})
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	attrs := NewFTAttributes()
+	attrs.RegisterType(timeType, timeAttributes{From: from})
+	got, err := attrs.GetAttributeGivenType(timeType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, ok := got.GetRandomValue().(time.Time); !ok || v.Before(from) {
+		t.Errorf("expected a time after %v, got %v", from, got.GetRandomValue())
+	}
+	if other, _ := attrs.GetAttributeGivenType(reflect.TypeOf(struct{ A int }{})); reflect.TypeOf(other) == reflect.TypeOf(timeAttributes{}) {
+		t.Error("expected other struct types to keep using StructAttr")
+	}
+	copied := attrs
+	attrs.RegisterType(timeType, nil)
+	if _, ok := copied.TypeAttrs[timeType]; !ok {
+		t.Error("expected earlier copies to keep their registrations")
+	}
+	if _, ok := attrs.TypeAttrs[timeType]; ok {
+		t.Error("expected registering nil to remove the registration")
+	}
+}
+
+func TestRegisterGlobalType(t *testing.T) {
+	timeType := reflect.TypeOf(time.Time{})
+	global := timeAttributes{From: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+	RegisterGlobalType(timeType, global)
+	defer RegisterGlobalType(timeType, nil)
+
+	attrs := NewFTAttributes()
+	if got, err := attrs.GetAttributeGivenType(timeType); err != nil || got.GetRandomValue().(time.Time).Year() != 2000 {
+		t.Fatalf("expected NewFTAttributes to use the global registration, got %v", err)
+	}
+	local := timeAttributes{From: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	attrs.RegisterType(timeType, local)
+	if got, _ := attrs.GetAttributeGivenType(timeType); got.GetRandomValue().(time.Time).Year() != 2030 {
+		t.Error("expected a local registration to override the global one")
+	}
+	if got, _ := NewFTAttributes().GetAttributeGivenType(timeType); got.GetRandomValue().(time.Time).Year() != 2000 {
+		t.Error("expected a local registration not to leak into the global registry")
+	}
+	RegisterGlobalType(timeType, nil)
+	if NewFTAttributes().TypeAttrs != nil {
+		t.Error("expected removing the last global registration to empty the registry")
+	}
+}
+
+func TestRegisterGlobalType_Concurrent(t *testing.T) {
+	type local struct{ N int }
+	localType := reflect.TypeOf(local{})
+	defer RegisterGlobalType(localType, nil)
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterGlobalType(localType, IntegerAttributesImpl[int]{Min: i, Max: i + 1})
+		}()
+		go func() {
+			defer wg.Done()
+			_ = NewFTAttributes()
+		}()
+	}
+	wg.Wait()
+}
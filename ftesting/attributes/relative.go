@@ -0,0 +1,183 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/laiambryant/gotestutils/utils"
+)
+
+// Relations accepted by RelativeAttributes.
+const (
+	RelationLess    = "less"
+	RelationGreater = "greater"
+	RelationEqual   = "equal"
+)
+
+// RelativeAttributes generates values from Base that stand in a given relation to a fixed
+// pivot, such as "an int greater than 10" or "a string lexically before \"m\"", for
+// targeted fuzzing of comparison-heavy code like binary searches and sorted inserts
+// around known boundary values.
+//
+// Fields:
+//   - Base: The attributes generating the candidate values
+//   - Pivot: The value the generated values are compared with; it must have the same
+//     ordered kind family as the values of Base (integer and float kinds mix freely as
+//     long as Pivot fits an integer type of Base exactly, strings go with strings)
+//   - Relation: RelationLess, RelationGreater or RelationEqual; the generated value v
+//     satisfies v < Pivot, v > Pivot or v == Pivot respectively
+//
+// Values are drawn from Base until the relation holds, up to the retry limit of
+// GenerationConfig.MaxRetries, after which GetRandomValue returns the zero value of the
+// type of Base and GenerateValue fails with a ConstraintUnsatisfiableError. Validate
+// checks that integer and float ranges of Base leave room for the relation;
+// RelationEqual therefore suits discrete bases best.
+//
+// Example usage:
+//
+//	attrs := RelativeAttributes{
+//	    Base:     IntegerAttributesImpl[int]{Min: 0, Max: 100},
+//	    Pivot:    42,
+//	    Relation: RelationGreater,
+//	}
+//	v := attrs.GetRandomValue() // an int in [43, 100]
+type RelativeAttributes struct {
+	Base     Attributes
+	Pivot    any
+	Relation string
+
+	gen generation
+}
+
+func (a RelativeAttributes) GetAttributes() any { return a }
+func (a RelativeAttributes) GetReflectType() reflect.Type {
+	if a.Base == nil {
+		return nil
+	}
+	return a.Base.GetReflectType()
+}
+func (a RelativeAttributes) GetDefaultImplementation() Attributes {
+	return RelativeAttributes{Base: IntegerAttributesImpl[int]{Min: -100, Max: 100}, Pivot: 0, Relation: RelationGreater}
+}
+
+func (a RelativeAttributes) GetRandomValue() any {
+	if a.Base == nil {
+		return nil
+	}
+	if v, ok := a.gen.generateUntil(
+		func() string { return fmt.Sprintf("%s %v", a.phrase(), a.Pivot) },
+		a.holds,
+		a.Base.GetRandomValue,
+	); ok {
+		return v
+	}
+	if t := a.Base.GetReflectType(); t != nil {
+		return reflect.Zero(t).Interface()
+	}
+	return nil
+}
+
+// withRetries returns a copy of the attributes that gives up after the retry limit of g
+func (a RelativeAttributes) withRetries(g generation) Attributes {
+	a.gen = g
+	return a
+}
+
+// holds reports whether v stands in the relation to the pivot, comparing them with
+// utils.Less once the pivot is converted to the type of v.
+func (a RelativeAttributes) holds(v any) bool {
+	pivot, ok := a.pivotAs(reflect.TypeOf(v))
+	if !ok {
+		return false
+	}
+	switch a.Relation {
+	case RelationLess:
+		return utils.Less(v, pivot)
+	case RelationGreater:
+		return utils.Less(pivot, v)
+	case RelationEqual:
+		return !utils.Less(v, pivot) && !utils.Less(pivot, v)
+	}
+	return false
+}
+
+// pivotAs returns the pivot converted to t, reporting false when it belongs to another
+// kind family or does not fit an integer type t exactly. Conversions to float types
+// round the pivot to the nearest value.
+func (a RelativeAttributes) pivotAs(t reflect.Type) (any, bool) {
+	pv := reflect.ValueOf(a.Pivot)
+	if !pv.IsValid() || t == nil || !utils.IsOrdered(pv.Type()) || !utils.IsOrdered(t) {
+		return nil, false
+	}
+	if (pv.Kind() == reflect.String) != (t.Kind() == reflect.String) {
+		return nil, false
+	}
+	if pv.Type() == t {
+		return a.Pivot, true
+	}
+	if isUnsigned(t) && ((pv.CanInt() && pv.Int() < 0) || (pv.CanFloat() && pv.Float() < 0)) {
+		return nil, false
+	}
+	converted := pv.Convert(t)
+	isFloat := t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+	if !isFloat && converted.Convert(pv.Type()).Interface() != a.Pivot {
+		return nil, false
+	}
+	return converted.Interface(), true
+}
+
+// Validate reports an InvalidAttributeError when Base is missing, Relation is unknown,
+// Pivot cannot be compared with the values of Base, or the integer or float range of
+// Base holds no value in the relation to Pivot.
+func (a RelativeAttributes) Validate() error {
+	invalid := func(reason string) error {
+		return InvalidAttributeError{Attribute: "RelativeAttributes", Reason: reason}
+	}
+	if a.Base == nil {
+		return invalid("Base must be set")
+	}
+	if a.Relation != RelationLess && a.Relation != RelationGreater && a.Relation != RelationEqual {
+		return invalid(fmt.Sprintf("unknown relation %q, expected %q, %q or %q", a.Relation, RelationLess, RelationGreater, RelationEqual))
+	}
+	pivot, ok := a.pivotAs(a.Base.GetReflectType())
+	if !ok {
+		return invalid(fmt.Sprintf("pivot %v (%T) cannot be compared with values of type %v", a.Pivot, a.Pivot, a.Base.GetReflectType()))
+	}
+	var min, max float64
+	switch base := a.Base.(type) {
+	case integerRange:
+		lo, hi, ok := base.intRange()
+		if !ok {
+			return nil
+		}
+		min, max = float64(lo), float64(hi)
+	case floatRange:
+		if min, max, ok = base.floatRange(); !ok {
+			return nil
+		}
+	default:
+		return nil
+	}
+	p := reflect.ValueOf(pivot).Convert(reflect.TypeFor[float64]()).Float()
+	if (a.Relation == RelationLess && min >= p) || (a.Relation == RelationGreater && max <= p) || (a.Relation == RelationEqual && (p < min || p > max)) {
+		return invalid(fmt.Sprintf("no value in [%v, %v] is %s %v", min, max, a.phrase(), a.Pivot))
+	}
+	return nil
+}
+
+// phrase returns the relation as used in messages, such as "less than".
+func (a RelativeAttributes) phrase() string {
+	if a.Relation == RelationEqual {
+		return "equal to"
+	}
+	return a.Relation + " than"
+}
+
+// isUnsigned reports whether t is an unsigned integer type.
+func isUnsigned(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
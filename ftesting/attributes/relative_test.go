@@ -0,0 +1,72 @@
+package attributes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRelativeAttributes(t *testing.T) {
+	greater := RelativeAttributes{Base: IntegerAttributesImpl[int]{Min: 0, Max: 100}, Pivot: 42, Relation: RelationGreater}
+	if greater.GetReflectType() != reflect.TypeOf(0) || !reflect.DeepEqual(greater.GetAttributes(), greater) {
+		t.Fatal("expected the type of Base and the attributes themselves")
+	}
+	for range 200 {
+		if v := greater.GetRandomValue().(int); v <= 42 || v > 100 {
+			t.Fatalf("expected ints in [43, 100], got %d", v)
+		}
+	}
+	before := RelativeAttributes{Base: StringAttributes{MinLen: 1, MaxLen: 3}, Pivot: "m", Relation: RelationLess}
+	for range 200 {
+		if v := before.GetRandomValue().(string); v >= "m" {
+			t.Fatalf("expected strings before \"m\", got %q", v)
+		}
+	}
+	equal := RelativeAttributes{Base: UnsignedIntegerAttributesImpl[uint8]{Min: 0, Max: 5}, Pivot: 3, Relation: RelationEqual}
+	if v := equal.GetRandomValue(); v != uint8(3) {
+		t.Errorf("expected the pivot converted to uint8, got %v (%T)", v, v)
+	}
+	below := RelativeAttributes{Base: FloatAttributesImpl[float32]{Min: 0, Max: 1}, Pivot: 0.1, Relation: RelationLess}
+	for range 200 {
+		if v := below.GetRandomValue().(float32); v >= 0.1 {
+			t.Fatalf("expected float32 values below 0.1, got %v", v)
+		}
+	}
+	if v := (RelativeAttributes{}).GetDefaultImplementation().GetRandomValue().(int); v <= 0 {
+		t.Errorf("expected positive ints by default, got %d", v)
+	}
+}
+
+func TestRelativeAttributes_Unsatisfiable(t *testing.T) {
+	attrs := RelativeAttributes{Base: StringAttributes{MinLen: 1, MaxLen: 1, AllowedRunes: []rune("xyz")}, Pivot: "a", Relation: RelationLess}
+	if v := attrs.GetRandomValue(); v != "" {
+		t.Errorf("expected the zero string once the retry budget is spent, got %q", v)
+	}
+	var cue ConstraintUnsatisfiableError
+	if _, err := GenerateValue(attrs); !errors.As(err, &cue) || cue.Constraint != "less than a" {
+		t.Errorf("expected ConstraintUnsatisfiableError, got %v", err)
+	}
+}
+
+func TestRelativeAttributes_Validate(t *testing.T) {
+	ints := IntegerAttributesImpl[int]{Min: 0, Max: 10}
+	var iae InvalidAttributeError
+	for _, attrs := range []RelativeAttributes{
+		{Pivot: 1, Relation: RelationLess},
+		{Base: ints, Pivot: 1, Relation: "around"},
+		{Base: ints, Pivot: "1", Relation: RelationLess},
+		{Base: ints, Pivot: 2.5, Relation: RelationLess},
+		{Base: UnsignedIntegerAttributesImpl[uint]{Min: 0, Max: 10}, Pivot: -1, Relation: RelationGreater},
+		{Base: ints, Pivot: 0, Relation: RelationLess},
+		{Base: ints, Pivot: 10, Relation: RelationGreater},
+		{Base: ints, Pivot: 11, Relation: RelationEqual},
+		{Base: FloatAttributesImpl[float64]{Min: 1, Max: 2}, Pivot: 1, Relation: RelationLess},
+	} {
+		if err := attrs.Validate(); !errors.As(err, &iae) {
+			t.Errorf("expected InvalidAttributeError for %+v, got %v", attrs, err)
+		}
+	}
+	if err := (RelativeAttributes{Base: ints, Pivot: int64(0), Relation: RelationGreater}).Validate(); err != nil {
+		t.Errorf("expected an int64 pivot to be valid for int values, got %v", err)
+	}
+}
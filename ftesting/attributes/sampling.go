@@ -0,0 +1,104 @@
+package attributes
+
+import (
+	"reflect"
+	"sort"
+)
+
+// integerRange is implemented by integer attributes that can expose their
+// configured range, enabling direct sampling of distinct values.
+type integerRange interface {
+	intRange() (min, max int64, ok bool)
+}
+
+// floatRange is implemented by float attributes that can expose their
+// configured range, enabling direct sampling of distinct values.
+type floatRange interface {
+	floatRange() (min, max float64, ok bool)
+}
+
+// valueSet tracks generated values to detect duplicates. Comparable types are
+// tracked in a map; anything else falls back to a reflect.DeepEqual scan.
+type valueSet struct {
+	hashable bool
+	keys     map[any]struct{}
+	values   []any
+}
+
+func newValueSet(t reflect.Type) *valueSet {
+	return &valueSet{
+		hashable: t != nil && t.Comparable() && t.Kind() != reflect.Interface,
+		keys:     map[any]struct{}{},
+	}
+}
+
+// add records v and reports whether it was not already present.
+func (s *valueSet) add(v reflect.Value) bool {
+	val := v.Interface()
+	if s.hashable {
+		if _, found := s.keys[val]; found {
+			return false
+		}
+		s.keys[val] = struct{}{}
+		return true
+	}
+	for _, existing := range s.values {
+		if reflect.DeepEqual(existing, val) {
+			return false
+		}
+	}
+	s.values = append(s.values, val)
+	return true
+}
+
+// sampleDistinctInts draws k distinct integers from [min, max] using Floyd's
+// algorithm and returns them sorted. k is capped to the size of the range.
+func sampleDistinctInts(min, max int64, k int) []int64 {
+	n := uint64(max-min) + 1
+	if n != 0 && uint64(k) > n {
+		k = int(n)
+	}
+	chosen := make(map[int64]struct{}, k)
+	for j := n - uint64(k); j < n; j++ {
+		t := int64(rng.Int63n(int64(j + 1)))
+		if _, found := chosen[t]; found {
+			t = int64(j)
+		}
+		chosen[t] = struct{}{}
+	}
+	out := make([]int64, 0, k)
+	for offset := range chosen {
+		out = append(out, min+offset)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// sampleDistinctFloats draws k distinct floats from [min, max) and returns them sorted,
+// returning fewer once retries consecutive draws produced only duplicates.
+func sampleDistinctFloats(min, max float64, k, retries int) []float64 {
+	chosen := make(map[float64]struct{}, k)
+	for misses := 0; len(chosen) < k && misses < retries; {
+		v := min + rng.Float64()*(max-min)
+		if _, found := chosen[v]; found {
+			misses++
+			continue
+		}
+		chosen[v] = struct{}{}
+	}
+	out := make([]float64, 0, len(chosen))
+	for v := range chosen {
+		out = append(out, v)
+	}
+	sort.Float64s(out)
+	return out
+}
+
+// convertAll converts each value to t.
+func convertAll[V int64 | float64](values []V, t reflect.Type) []reflect.Value {
+	out := make([]reflect.Value, len(values))
+	for i, v := range values {
+		out[i] = reflect.ValueOf(v).Convert(t)
+	}
+	return out
+}
@@ -240,3 +240,155 @@ func TestSliceAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestSliceAttributes_MaxCap(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 1, MaxLen: 4, MaxCap: 10, ElementAttrs: IntegerAttributesImpl[int]{Min: 0, Max: 10}}
+	sawExtraCap := false
+	for range 200 {
+		sv := reflect.ValueOf(attrs.GetRandomValue())
+		if sv.Cap() < sv.Len() {
+			t.Fatalf("expected cap >= len, got len %d cap %d", sv.Len(), sv.Cap())
+		}
+		if sv.Cap() > 10 {
+			t.Fatalf("expected cap <= MaxCap, got %d", sv.Cap())
+		}
+		if sv.Cap() > sv.Len() {
+			sawExtraCap = true
+		}
+	}
+	if !sawExtraCap {
+		t.Error("expected at least one slice with spare capacity")
+	}
+}
+
+func TestSliceAttributes_MaxCapBelowLength(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 5, MaxLen: 5, MaxCap: 2, ElementAttrs: IntegerAttributesImpl[int]{Min: 0, Max: 10}}
+	sv := reflect.ValueOf(attrs.GetRandomValue())
+	if sv.Len() != 5 || sv.Cap() != 5 {
+		t.Errorf("expected len and cap 5, got len %d cap %d", sv.Len(), sv.Cap())
+	}
+}
+
+func TestSliceAttributes_SortedUniqueIntegers(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 8, MaxLen: 8, Unique: true, Sorted: true, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 10}}
+	for range 100 {
+		got := attrs.GetRandomValue().([]int)
+		if len(got) != 8 {
+			t.Fatalf("expected 8 elements, got %v", got)
+		}
+		for i := 1; i < len(got); i++ {
+			if got[i] <= got[i-1] {
+				t.Fatalf("expected strictly increasing values, got %v", got)
+			}
+		}
+		if got[0] < 1 || got[len(got)-1] > 10 {
+			t.Fatalf("expected values within [1, 10], got %v", got)
+		}
+	}
+}
+
+func TestSliceAttributes_SortedUniqueCapsToDomain(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 20, MaxLen: 20, Unique: true, Sorted: true, ElementAttrs: IntegerAttributesImpl[int8]{Min: 1, Max: 5}}
+	got := attrs.GetRandomValue().([]int8)
+	if !reflect.DeepEqual(got, []int8{1, 2, 3, 4, 5}) {
+		t.Errorf("expected the whole domain in order, got %v", got)
+	}
+}
+
+func TestSliceAttributes_SortedUniqueFloats(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 10, MaxLen: 10, Unique: true, Sorted: true, ElementAttrs: FloatAttributesImpl[float64]{Min: -1, Max: 1}}
+	got := attrs.GetRandomValue().([]float64)
+	if len(got) != 10 {
+		t.Fatalf("expected 10 elements, got %v", got)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i] <= got[i-1] {
+			t.Fatalf("expected strictly increasing values, got %v", got)
+		}
+	}
+}
+
+func TestSliceAttributes_UniqueFallbackStrings(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 10, MaxLen: 10, Unique: true, Sorted: true, ElementAttrs: StringAttributes{MinLen: 1, MaxLen: 1, AllowedRunes: []rune("abc")}}
+	got := attrs.GetRandomValue().([]string)
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("expected the three distinct strings in order, got %v", got)
+	}
+}
+
+func TestSliceAttributes_SortedOnly(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 10, MaxLen: 10, Sorted: true, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 3}}
+	got := attrs.GetRandomValue().([]int)
+	for i := 1; i < len(got); i++ {
+		if got[i] < got[i-1] {
+			t.Fatalf("expected non-decreasing values, got %v", got)
+		}
+	}
+}
+
+type lessTestRecord struct {
+	Key int
+}
+
+func TestSliceAttributes_SortedWithLess(t *testing.T) {
+	attrs := SliceAttributes{
+		MinLen:       6,
+		MaxLen:       6,
+		Sorted:       true,
+		ElementAttrs: StructAttributes{FieldAttrs: map[string]any{"Key": IntegerAttributesImpl[int]{Min: 1, Max: 100}}},
+		Less: func(a, b any) bool {
+			return reflect.ValueOf(a).FieldByName("Key").Int() < reflect.ValueOf(b).FieldByName("Key").Int()
+		},
+	}
+	sv := reflect.ValueOf(attrs.GetRandomValue())
+	for i := 1; i < sv.Len(); i++ {
+		if sv.Index(i).FieldByName("Key").Int() < sv.Index(i-1).FieldByName("Key").Int() {
+			t.Fatalf("expected structs sorted by Key, got %v", sv.Interface())
+		}
+	}
+}
+
+func TestSliceAttributes_UniqueUsesMaxRetries(t *testing.T) {
+	attrs := FTAttributes{
+		SliceAttr:  SliceAttributes{MinLen: 5, MaxLen: 5, Unique: true, ElementAttrs: BoolAttributes{}},
+		Generation: GenerationConfig{MaxRetries: 1},
+	}
+	sliceAttr, err := attrs.GetAttributeGivenType(reflect.TypeOf([]bool{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	short := false
+	for range 50 {
+		s := sliceAttr.GetRandomValue().([]bool)
+		if len(s) == 0 || len(s) > 2 {
+			t.Fatalf("expected 1 or 2 distinct bools, got %v", s)
+		}
+		short = short || len(s) == 1
+	}
+	if !short {
+		t.Error("expected a single duplicate to stop generation with MaxRetries 1")
+	}
+	if got := sampleDistinctFloats(0, 1, 5, 0); len(got) != 0 {
+		t.Errorf("expected no draws without retries, got %v", got)
+	}
+}
+
+func TestSliceAttributes_UniqueSortedWithLess(t *testing.T) {
+	desc := func(a, b any) bool { return a.(int) > b.(int) }
+	attrs := SliceAttributes{MinLen: 5, MaxLen: 5, Unique: true, Sorted: true, Less: desc, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 5}}
+	got := attrs.GetRandomValue().([]int)
+	if !reflect.DeepEqual(got, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("expected descending order from comparator, got %v", got)
+	}
+}
+
+func TestSliceAttributes_ElementPredsResample(t *testing.T) {
+	attrs := SliceAttributes{MinLen: 5, MaxLen: 5, Sorted: true, Unique: true, ElementAttrs: IntegerAttributesImpl[int]{Min: 1, Max: 100}, ElementPreds: []p.Predicate{evenInt{}}}
+	for range 20 {
+		for _, v := range attrs.GetRandomValue().([]int) {
+			if v%2 != 0 {
+				t.Fatalf("expected only even elements, got %d", v)
+			}
+		}
+	}
+}
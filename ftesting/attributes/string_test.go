@@ -2,7 +2,10 @@ package attributes
 
 import (
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	ctesting "github.com/laiambryant/gotestutils/ctesting"
 )
@@ -163,3 +166,124 @@ func TestStringAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestStringAttributes_Template(t *testing.T) {
+	attrs := StringAttributes{Template: "user-###-@@@", Prefix: "<", Suffix: ">"}
+	pattern := regexp.MustCompile(`^<user-[0-9]{3}-[a-zA-Z]{3}>$`)
+	for range 50 {
+		got := attrs.GetRandomValue().(string)
+		if !pattern.MatchString(got) {
+			t.Fatalf("expected template expansion, got %q", got)
+		}
+	}
+}
+
+func TestStringAttributes_TemplateEscapes(t *testing.T) {
+	attrs := StringAttributes{Template: `\#\@\\#`}
+	got := attrs.GetRandomValue().(string)
+	if len(got) != 4 || got[:3] != `#@\` || got[3] < '0' || got[3] > '9' {
+		t.Errorf("expected escaped literals followed by a digit, got %q", got)
+	}
+	trailing := StringAttributes{Template: `ab\`}
+	if got := trailing.GetRandomValue().(string); got != `ab\` {
+		t.Errorf("expected trailing backslash to be kept, got %q", got)
+	}
+}
+
+func TestStringAttributes_ValidUTF8ByDefault(t *testing.T) {
+	attrs := StringAttributes{MinLen: 0, MaxLen: 20, AllowedRunes: []rune("aé世\U0001F600\xff")}
+	for i := 0; i < 200; i++ {
+		if s := attrs.GetRandomValue().(string); !utf8.ValidString(s) {
+			t.Fatalf("expected valid UTF-8, got %q", s)
+		}
+	}
+}
+
+func TestStringAttributes_AllowInvalidUTF8(t *testing.T) {
+	attrs := StringAttributes{MinLen: 0, MaxLen: 10, AllowedRunes: []rune("ab世"), Prefix: "<", Suffix: ">", AllowInvalidUTF8: true}
+	invalid := 0
+	for i := 0; i < 200; i++ {
+		s := attrs.GetRandomValue().(string)
+		if !strings.HasPrefix(s, "<") || !strings.HasSuffix(s, ">") {
+			t.Fatalf("expected prefix and suffix to be kept intact, got %q", s)
+		}
+		if !utf8.ValidString(s) {
+			invalid++
+		}
+	}
+	if invalid == 0 || invalid == 200 {
+		t.Errorf("expected invalid UTF-8 in some but not all strings, got %d of 200", invalid)
+	}
+}
+
+func TestStringAttributes_UniqueChars(t *testing.T) {
+	attrs := StringAttributes{MinLen: 5, MaxLen: 5, AllowedRunes: []rune("abcdef"), UniqueChars: true}
+	for range 50 {
+		s := attrs.GetRandomValue().(string)
+		seen := map[rune]bool{}
+		for _, r := range s {
+			if seen[r] {
+				t.Fatalf("expected distinct characters, got %q", s)
+			}
+			seen[r] = true
+		}
+	}
+	_, err := GenerateValue(StringAttributes{MinLen: 3, MaxLen: 3, AllowedRunes: []rune("ab"), UniqueChars: true})
+	if _, ok := err.(ConstraintUnsatisfiableError); !ok {
+		t.Errorf("expected ConstraintUnsatisfiableError when too few runes are allowed, got %v", err)
+	}
+}
+
+func TestStringAttributes_LengthDistribution(t *testing.T) {
+	counts := func(attrs StringAttributes, n int) map[int]int {
+		lengths := map[int]int{}
+		for range n {
+			s := attrs.GetRandomValue().(string)
+			if len(s) < attrs.MinLen || len(s) > attrs.MaxLen {
+				t.Fatalf("expected a length in [%d, %d], got %d", attrs.MinLen, attrs.MaxLen, len(s))
+			}
+			lengths[len(s)]++
+		}
+		return lengths
+	}
+	exponential := counts(StringAttributes{MinLen: 2, MaxLen: 1000, LengthDistribution: LengthDistribution{Kind: LengthExponential, Mean: 3}}, 2000)
+	short, long := 0, 0
+	for length, n := range exponential {
+		if length <= 12 {
+			short += n
+		} else if length > 30 {
+			long += n
+		}
+	}
+	if short < 1800 || long > 50 {
+		t.Errorf("expected mostly short strings, got %d of length <= 12 and %d longer than 30", short, long)
+	}
+	if defaultMean := counts(StringAttributes{MinLen: 0, MaxLen: 3, LengthDistribution: LengthDistribution{Kind: LengthExponential}}, 500); defaultMean[0] <= defaultMean[3] {
+		t.Errorf("expected short strings to be more frequent, got %v", defaultMean)
+	}
+	weighted := counts(StringAttributes{MinLen: 1, MaxLen: 5, LengthDistribution: LengthDistribution{Kind: LengthWeighted, Weights: []float64{0, 1, 0, 3}}}, 1000)
+	if weighted[1] != 0 || weighted[3] != 0 || weighted[5] != 0 || weighted[4] < 2*weighted[2] {
+		t.Errorf("expected lengths 2 and 4 drawn one to three, got %v", weighted)
+	}
+	if uniform := counts(StringAttributes{MinLen: 0, MaxLen: 2, LengthDistribution: LengthDistribution{Kind: LengthUniform}}, 300); len(uniform) != 3 {
+		t.Errorf("expected every length to be drawn, got %v", uniform)
+	}
+}
+
+func TestStringAttributes_LengthDistributionValidate(t *testing.T) {
+	for _, d := range []LengthDistribution{
+		{Kind: "zipf"},
+		{Kind: LengthExponential, Mean: -1},
+		{Kind: LengthWeighted},
+		{Kind: LengthWeighted, Weights: []float64{1, -1}},
+		{Kind: LengthWeighted, Weights: []float64{0, 0, 0, 0, 1}},
+	} {
+		attrs := FTAttributes{StringAttr: StringAttributes{MinLen: 1, MaxLen: 3, LengthDistribution: d}}
+		if _, err := attrs.GetAttributeGivenType(reflect.TypeOf("")); err == nil {
+			t.Errorf("expected %+v to be rejected", d)
+		}
+	}
+	if err := (StringAttributes{MinLen: 1, MaxLen: 3, LengthDistribution: LengthDistribution{Kind: LengthWeighted, Weights: []float64{0, 2}}}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
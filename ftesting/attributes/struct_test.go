@@ -1,6 +1,7 @@
 package attributes
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -151,3 +152,142 @@ func TestStructAttributes_SetFieldValueConversion(t *testing.T) {
 		t.Error("Expected StringField to be set via conversion")
 	}
 }
+
+type celsius float32
+
+type address struct {
+	Street string
+	Zip    [5]uint8
+}
+
+type person struct {
+	Name     string
+	Age      int
+	Temp     celsius
+	Tags     []string
+	Scores   map[string]int
+	Home     address
+	Manager  *person
+	internal int
+}
+
+func TestStructAttributes_TargetType(t *testing.T) {
+	attrs := StructAttributes{
+		TargetType: reflect.TypeOf(person{}),
+		FieldAttrs: map[string]any{"Age": IntegerAttributesImpl[int]{Min: 18, Max: 18}},
+	}
+	if attrs.GetReflectType() != reflect.TypeOf(person{}) {
+		t.Fatalf("expected the target type, got %v", attrs.GetReflectType())
+	}
+	p, ok := attrs.GetRandomValue().(person)
+	if !ok {
+		t.Fatalf("expected a person, got %T", attrs.GetRandomValue())
+	}
+	if p.Age != 18 || p.Name != "" || p.Tags != nil || p.Manager != nil {
+		t.Errorf("expected only the listed field to be set, got %+v", p)
+	}
+}
+
+func TestStructAttributes_FillUnlisted(t *testing.T) {
+	attrs := StructAttributes{
+		TargetType:   reflect.TypeOf(person{}),
+		FieldAttrs:   map[string]any{"Age": IntegerAttributesImpl[int]{Min: 18, Max: 18}},
+		FillUnlisted: true,
+	}
+	sawManager := false
+	for range 50 {
+		p := attrs.GetRandomValue().(person)
+		if p.Age != 18 {
+			t.Fatalf("expected FieldAttrs to override the defaults, got Age %d", p.Age)
+		}
+		if p.Name == "" || len(p.Tags) == 0 || len(p.Scores) == 0 || p.Home.Street == "" {
+			t.Fatalf("expected unlisted exported fields to be filled, got %+v", p)
+		}
+		if p.internal != 0 {
+			t.Fatalf("expected unexported fields to be skipped, got %d", p.internal)
+		}
+		if p.Manager != nil {
+			sawManager = true
+			if p.Manager.Manager != nil && p.Manager.Manager.Manager != nil {
+				t.Fatalf("expected self-referential fields to stop at the fill depth")
+			}
+		}
+	}
+	if !sawManager {
+		t.Error("expected pointer fields to be filled at least once")
+	}
+}
+
+func TestStructAttributes_TargetTypeValidate(t *testing.T) {
+	if err := (StructAttributes{TargetType: reflect.TypeOf(0)}).Validate(); err == nil {
+		t.Error("expected a non-struct TargetType to be rejected")
+	}
+	if (StructAttributes{TargetType: reflect.TypeOf(0)}).GetRandomValue() != nil {
+		t.Error("expected no value for a non-struct TargetType")
+	}
+}
+
+type timeRange struct {
+	Start int
+	End   int
+	Label string
+}
+
+func TestStructAttributes_Relation(t *testing.T) {
+	ordered := func(fields map[string]any) bool { return fields["End"].(int) >= fields["Start"].(int) }
+	resampled := StructAttributes{
+		TargetType: reflect.TypeOf(timeRange{}),
+		FieldAttrs: map[string]any{
+			"Start": IntegerAttributesImpl[int]{Min: 0, Max: 10},
+			"End":   IntegerAttributesImpl[int]{Min: 0, Max: 100},
+		},
+		FillUnlisted:   true,
+		Relation:       ordered,
+		ResampleFields: []string{"End"},
+	}
+	swap := resampled
+	swap.ResampleFields = nil
+	swap.Repair = func(fields map[string]any) { fields["Start"], fields["End"] = fields["End"], fields["Start"] }
+	for _, attrs := range []StructAttributes{resampled, swap} {
+		if err := attrs.Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for range 200 {
+			v, err := GenerateValue(attrs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if r := v.(timeRange); r.End < r.Start {
+				t.Fatalf("expected End >= Start, got %+v", r)
+			}
+		}
+	}
+	impossible := resampled
+	impossible.Relation = func(fields map[string]any) bool { return fields["End"].(int) > 100 }
+	if v := impossible.GetRandomValue(); v != (timeRange{}) {
+		t.Errorf("expected the zero struct once the retry budget is spent, got %+v", v)
+	}
+	var cue ConstraintUnsatisfiableError
+	if _, err := GenerateValue(impossible); !errors.As(err, &cue) {
+		t.Errorf("expected ConstraintUnsatisfiableError, got %v", err)
+	}
+}
+
+func TestStructAttributes_RelationValidate(t *testing.T) {
+	ordered := func(fields map[string]any) bool { return true }
+	fieldAttrs := map[string]any{"Start": IntegerAttributesImpl[int]{}}
+	for _, attrs := range []StructAttributes{
+		{FieldAttrs: fieldAttrs, ResampleFields: []string{"Start"}},
+		{FieldAttrs: fieldAttrs, Repair: func(map[string]any) {}},
+		{FieldAttrs: fieldAttrs, Relation: ordered, ResampleFields: []string{"End"}},
+		{TargetType: reflect.TypeOf(timeRange{}), FillUnlisted: true, Relation: ordered, ResampleFields: []string{"Missing"}},
+	} {
+		if err := attrs.Validate(); err == nil {
+			t.Errorf("expected %+v to be rejected", attrs.ResampleFields)
+		}
+	}
+	valid := StructAttributes{TargetType: reflect.TypeOf(timeRange{}), FillUnlisted: true, Relation: ordered, ResampleFields: []string{"Label"}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("expected unlisted fields to be resampled with FillUnlisted, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package attributes
+
+import "reflect"
+
+// maxFillDepth bounds how deeply attributesForType descends into nested and
+// self-referential types; deeper values are left at their zero value.
+const maxFillDepth = 3
+
+// typedAttributes generates values with attrs and converts them to t, so that default
+// attributes can fill values of named or differently sized types (for example a
+// type Celsius float32 field from the default float64 attributes).
+type typedAttributes struct {
+	attrs Attributes
+	t     reflect.Type
+}
+
+func (ta typedAttributes) GetAttributes() any                   { return ta }
+func (ta typedAttributes) GetReflectType() reflect.Type         { return ta.t }
+func (ta typedAttributes) GetDefaultImplementation() Attributes { return ta }
+func (ta typedAttributes) GetRandomValue() any {
+	return randomValueOf(ta.attrs, ta.t).Interface()
+}
+
+// withGeneration returns a copy whose wrapped attributes are generated under g
+func (ta typedAttributes) withGeneration(g generation) Attributes {
+	if attrs, ok := bindGeneration(ta.attrs, g).(Attributes); ok {
+		ta.attrs = attrs
+	}
+	return ta
+}
+
+// attributesForType builds default attributes generating values of exactly type t,
+// descending into the element, key and field types of composite types. depth is the
+// nesting level of t; at maxFillDepth and for kinds without a generator (channels,
+// functions, interfaces) it returns nil, which callers turn into the zero value.
+func attributesForType(t reflect.Type, depth int) Attributes {
+	if t == nil || depth >= maxFillDepth {
+		return nil
+	}
+	var attrs Attributes
+	switch t.Kind() {
+	case reflect.Struct:
+		return StructAttributes{TargetType: t, FillUnlisted: true, depth: depth}
+	case reflect.Slice:
+		defaults := SliceAttributes{}.GetDefaultImplementation().(SliceAttributes)
+		attrs = SliceAttributes{MinLen: defaults.MinLen, MaxLen: defaults.MaxLen, ElementAttrs: elementAttributes(t.Elem(), depth)}
+	case reflect.Array:
+		attrs = ArrayAttributes{Length: t.Len(), ElementAttrs: elementAttributes(t.Elem(), depth)}
+	case reflect.Map:
+		defaults := MapAttributes{}.GetDefaultImplementation().(MapAttributes)
+		attrs = MapAttributes{MinSize: defaults.MinSize, MaxSize: defaults.MaxSize, KeyAttrs: elementAttributes(t.Key(), depth), ValueAttrs: elementAttributes(t.Elem(), depth)}
+	case reflect.Pointer:
+		attrs = PointerAttributes{AllowNil: true, Depth: 1, Inner: elementAttributes(t.Elem(), depth)}
+	default:
+		defaults, err := FTAttributes{}.getDefaultForKind(t.Kind())
+		if err != nil {
+			return nil
+		}
+		attrs = defaults
+	}
+	return typedAttributes{attrs: attrs, t: t}
+}
+
+// elementAttributes returns attributes for an element of a composite at depth, falling
+// back to the bare element type (which generates zero values) past maxFillDepth.
+func elementAttributes(t reflect.Type, depth int) any {
+	if attrs := attributesForType(t, depth+1); attrs != nil {
+		return attrs
+	}
+	return typedAttributes{t: t}
+}
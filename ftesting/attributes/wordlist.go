@@ -0,0 +1,121 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// defaultMaxWords is the maximum number of words joined when WordListAttributes leaves
+// MaxWords unset.
+const defaultMaxWords = 5
+
+// WordListAttributes configures the generation of strings made of words drawn from a
+// list, for fuzzing tokenizers, search and other text processing with plausible inputs
+// rather than random characters.
+//
+// Fields:
+//   - Words: The words to draw from; the same word may be drawn several times
+//   - Weights: Optional relative weights, one per word; when empty every word is equally
+//     likely
+//   - MinWords: Minimum number of words joined (inclusive)
+//   - MaxWords: Maximum number of words joined (inclusive); defaults to 5 when not positive
+//   - Separator: The string placed between words; defaults to a single space when empty
+//
+// Setting FTAttributes.WordListAttr with a non-empty Words list makes every string
+// parameter be generated from it instead of from StringAttr. WordListAttributes can also
+// be used wherever string attributes are accepted, such as slice ElementAttrs, map
+// KeyAttrs or struct FieldAttrs.
+//
+// Example usage:
+//
+//	attrs := WordListAttributes{
+//	    Words:    []string{"the", "quick", "brown", "fox"},
+//	    Weights:  []float64{4, 1, 1, 1},
+//	    MinWords: 2,
+//	    MaxWords: 6,
+//	}
+//	sentence := attrs.GetRandomValue() // e.g. "the fox the brown"
+type WordListAttributes struct {
+	Words     []string
+	Weights   []float64
+	MinWords  int
+	MaxWords  int
+	Separator string
+}
+
+func (a WordListAttributes) GetAttributes() any           { return a }
+func (a WordListAttributes) GetReflectType() reflect.Type { return reflect.TypeOf("") }
+
+func (a WordListAttributes) GetDefaultImplementation() Attributes {
+	return WordListAttributes{
+		Words:    []string{"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit"},
+		MinWords: 1,
+		MaxWords: defaultMaxWords,
+	}
+}
+
+func (a WordListAttributes) GetRandomValue() any {
+	if len(a.Words) == 0 {
+		return ""
+	}
+	minWords, maxWords := a.MinWords, a.MaxWords
+	if maxWords <= 0 {
+		maxWords = defaultMaxWords
+	}
+	minWords = min(max(minWords, 0), maxWords)
+	n := minWords + rng.Intn(maxWords-minWords+1)
+	separator := a.Separator
+	if separator == "" {
+		separator = " "
+	}
+	words := make([]string, n)
+	for i := range words {
+		words[i] = a.Words[a.pickWord()]
+	}
+	return strings.Join(words, separator)
+}
+
+// pickWord returns the index of a random word, drawn according to Weights when set.
+func (a WordListAttributes) pickWord() int {
+	if len(a.Weights) != len(a.Words) {
+		return rng.Intn(len(a.Words))
+	}
+	total := 0.0
+	for _, w := range a.Weights {
+		total += w
+	}
+	r := rng.Float64() * total
+	for i, w := range a.Weights {
+		if r < w {
+			return i
+		}
+		r -= w
+	}
+	return len(a.Words) - 1
+}
+
+// Validate reports an InvalidAttributeError when Weights does not hold one non-negative
+// weight per word with a positive sum.
+func (a WordListAttributes) Validate() error {
+	if len(a.Weights) == 0 {
+		return nil
+	}
+	if len(a.Weights) != len(a.Words) {
+		return InvalidAttributeError{
+			Attribute: "WordListAttributes",
+			Reason:    fmt.Sprintf("%d weights for %d words", len(a.Weights), len(a.Words)),
+		}
+	}
+	total := 0.0
+	for _, w := range a.Weights {
+		if w < 0 {
+			return InvalidAttributeError{Attribute: "WordListAttributes", Reason: "weights must not be negative"}
+		}
+		total += w
+	}
+	if total == 0 {
+		return InvalidAttributeError{Attribute: "WordListAttributes", Reason: "weights must not all be zero"}
+	}
+	return nil
+}
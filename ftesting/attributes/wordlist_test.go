@@ -0,0 +1,79 @@
+package attributes
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWordListAttributes(t *testing.T) {
+	attrs := WordListAttributes{Words: []string{"alpha", "beta", "gamma"}, MinWords: 2, MaxWords: 4, Separator: "-"}
+	if attrs.GetReflectType() != reflect.TypeOf("") || !reflect.DeepEqual(attrs.GetAttributes(), attrs) {
+		t.Fatal("expected string type and the attributes themselves")
+	}
+	for range 100 {
+		words := strings.Split(attrs.GetRandomValue().(string), "-")
+		if len(words) < 2 || len(words) > 4 {
+			t.Fatalf("expected 2 to 4 words, got %v", words)
+		}
+		for _, w := range words {
+			if w != "alpha" && w != "beta" && w != "gamma" {
+				t.Fatalf("expected words from the list, got %q", w)
+			}
+		}
+	}
+	if got := (WordListAttributes{}).GetRandomValue(); got != "" {
+		t.Errorf("expected an empty string without words, got %q", got)
+	}
+	def := WordListAttributes{}.GetDefaultImplementation().GetRandomValue().(string)
+	if n := len(strings.Fields(def)); n < 1 || n > defaultMaxWords {
+		t.Errorf("expected 1 to %d space-separated words by default, got %q", defaultMaxWords, def)
+	}
+}
+
+func TestWordListAttributes_Weights(t *testing.T) {
+	attrs := WordListAttributes{Words: []string{"common", "rare", "never"}, Weights: []float64{9, 1, 0}, MinWords: 1, MaxWords: 1}
+	counts := map[string]int{}
+	for range 2000 {
+		counts[attrs.GetRandomValue().(string)]++
+	}
+	if counts["never"] != 0 || counts["rare"] == 0 || counts["common"] < 5*counts["rare"] {
+		t.Errorf("expected words drawn in proportion to their weights, got %v", counts)
+	}
+}
+
+func TestWordListAttributes_Validate(t *testing.T) {
+	var iae InvalidAttributeError
+	for _, attrs := range []WordListAttributes{
+		{Words: []string{"a", "b"}, Weights: []float64{1}},
+		{Words: []string{"a"}, Weights: []float64{-1}},
+		{Words: []string{"a", "b"}, Weights: []float64{0, 0}},
+	} {
+		if err := attrs.Validate(); !errors.As(err, &iae) {
+			t.Errorf("expected InvalidAttributeError for %+v, got %v", attrs, err)
+		}
+	}
+	if err := (WordListAttributes{Words: []string{"a"}}).Validate(); err != nil {
+		t.Errorf("expected unweighted words to be valid, got %v", err)
+	}
+}
+
+func TestGetAttributeGivenType_WordList(t *testing.T) {
+	attrs := NewFTAttributes()
+	attrs.WordListAttr = WordListAttributes{Words: []string{"go"}, MinWords: 3, MaxWords: 3}
+	got, err := attrs.GetAttributeGivenType(reflect.TypeOf(""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v := got.GetRandomValue(); v != "go go go" {
+		t.Errorf("expected strings from the word list, got %q", v)
+	}
+	attrs.WordListAttr.Weights = []float64{1, 2}
+	if _, err := attrs.GetAttributeGivenType(reflect.TypeOf("")); err == nil {
+		t.Error("expected the word list to be validated")
+	}
+	if got, _ := NewFTAttributes().GetAttributeGivenType(reflect.TypeOf("")); reflect.TypeOf(got) != reflect.TypeOf(StringAttributes{}) {
+		t.Errorf("expected StringAttr without words, got %T", got)
+	}
+}
@@ -42,13 +42,36 @@
 package ftesting
 
 import (
+	"bufio"
 	"fmt"
+	"maps"
+	"math"
+	"math/cmplx"
+	"os"
 	"reflect"
+	"runtime/debug"
+	"slices"
 	"testing"
 
 	a "github.com/laiambryant/gotestutils/ftesting/attributes"
+	"github.com/laiambryant/gotestutils/utils"
 )
 
+// maxVariadicArgs is the maximum number of values generated for the variadic
+// parameter of a variadic function.
+const maxVariadicArgs = 5
+
+// seedMutationOdds is the one-in-n chance that an input tuple generated after the seed
+// corpus has been replayed is a mutation of a seed rather than purely random.
+const seedMutationOdds = 4
+
+// defaultUniqueArgRetries is the number of redraws allowed per tuple for an argument made
+// unique with WithUniqueArg when no budget is given.
+const defaultUniqueArgRetries = 100
+
+// errorType is the reflect.Type of the error interface.
+var errorType = reflect.TypeFor[error]()
+
 // FTesting represents a fuzz testing suite that generates random inputs
 // for testing functions with arbitrary signatures.
 //
@@ -61,16 +84,43 @@ import (
 //   - iterations: Number of test iterations to run
 //   - attributes: Configuration for random value generation per type
 //   - t: The testing.T instance for reporting results
+//   - zeroFirst: If true, the first generated inputs are the zero values of the parameters
+//   - errorIsFailure: If true, a non-nil error returned by the function fails ApplyFunction
+//   - oracle: If set, a reference implementation ApplyFunction compares the function against
+//   - seedCorpus: User-provided input tuples replayed and mutated by GenerateInputs
+//   - seedsReplayed: Number of seed tuples replayed verbatim so far
+//   - fixedArgs: Arguments held at a constant value, by parameter index
+//   - argGroups: Attributes generating consecutive arguments together, by first parameter index
+//   - uniqueArgs: Redraw budgets of the arguments that must not repeat, by parameter index
+//   - seenArgs: The values already returned for each unique argument
+//   - generated: Number of input tuples generated so far
+//   - truncated: Number of generated values cut short by MaxGeneratedElements so far
+//   - inputLogPath, inputLogFile, inputLog: Destination and open buffered writer of the input log
 //
 // Example usage:
 //
 //	ft := &FTesting{}
 //	ft.WithFunction(myFunc).WithIterations(100).WithAttributes(customAttrs).Verify()
 type FTesting struct {
-	f          any
-	iterations uint
-	attributes a.AttributesStruct
-	t          *testing.T
+	f              any
+	iterations     uint
+	attributes     a.AttributesStruct
+	t              *testing.T
+	zeroFirst      bool
+	errorIsFailure bool
+	oracle         any
+	seedCorpus     [][]any
+	seedsReplayed  int
+	fixedArgs      map[int]any
+	argGroups      map[int]a.Attributes
+	uniqueArgs     map[int]int
+	seenArgs       map[int]map[string]bool
+	generated      uint
+	truncated      uint
+
+	inputLogPath string
+	inputLogFile *os.File
+	inputLog     *bufio.Writer
 }
 
 // WithIterations sets the number of iterations for the fuzz test.
@@ -105,6 +155,180 @@ func (mt *FTesting) WithFunction(f any) *FTesting {
 	return mt
 }
 
+// WithZeroFirst makes the first call to GenerateInputs return the zero value of every
+// parameter (0, "", false, typed nil pointers, slices and maps, ...) before generation
+// proceeds randomly, so that the degenerate all-zero case is always exercised.
+//
+// Parameters:
+//   - zeroFirst: true to start with zero-valued inputs
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	ft.WithFunction(parse).WithZeroFirst(true)
+//	inputs, _ := ft.GenerateInputs() // []any{""}
+func (mt *FTesting) WithZeroFirst(zeroFirst bool) *FTesting {
+	mt.zeroFirst = zeroFirst
+	return mt
+}
+
+// WithSeedCorpus provides known-interesting input tuples that GenerateInputs mixes with
+// random ones. Every seed is first replayed verbatim, in order (after the zero inputs of
+// WithZeroFirst); afterwards one in four generated tuples is a light mutation of a
+// random seed, with one parameter replaced by a freshly generated value, and the rest
+// are purely random.
+//
+// Each seed must hold one value per fixed parameter (plus any number of variadic
+// arguments) of a type assignable to the parameter type, or a number of the same family
+// (signed or unsigned integers, floats or complex numbers) that fits it, such as an int
+// for an int8 parameter; nil is accepted for pointers, slices, maps, interfaces,
+// functions and channels. Other conversions, such as a slice to an array or an int to a
+// string, are rejected, and GenerateInputs returns an InvalidSeedError when it reaches a
+// seed that does not fit the signature.
+//
+// Parameters:
+//   - seeds: The seed input tuples
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	ft.WithFunction(divide).WithSeedCorpus([]any{1, 0}, []any{math.MinInt, -1})
+func (mt *FTesting) WithSeedCorpus(seeds ...[]any) *FTesting {
+	mt.seedCorpus = seeds
+	mt.seedsReplayed = 0
+	return mt
+}
+
+// WithFixedArg holds the argument at index to value while the other arguments keep being
+// fuzzed, which helps isolate the argument driving a failure or explore one dimension at
+// a time. The fixed value replaces the argument in every tuple GenerateInputs returns,
+// including zero inputs and seeds, and must fit the parameter type like a seed value:
+// assignable to it, or a number of the same family that fits it. GenerateInputs returns
+// an InvalidFixedArgError when it does not fit. Fixed arguments are never generated, so
+// they may have types no attributes can produce, such as channels. Calling WithFixedArg
+// again for the same index replaces the value.
+//
+// Parameters:
+//   - index: The position of the parameter to hold fixed
+//   - value: The value passed for that parameter
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	ft.WithFunction(divide).WithFixedArg(1, 3) // fuzz the dividend only
+func (mt *FTesting) WithFixedArg(index int, value any) *FTesting {
+	if mt.fixedArgs == nil {
+		mt.fixedArgs = map[int]any{}
+	}
+	mt.fixedArgs[index] = value
+	return mt
+}
+
+// WithArgGroup generates the arguments starting at index together from attrs, so that
+// correlated parameters such as the start and end of an interval receive consistent
+// values. Every generated value must be an array or slice; its elements are passed for
+// the parameters index, index+1 and so on, and must fit the parameter types like seed
+// values: assignable to them, or numbers of the same family that fit them, such as ints
+// for a named integer type. GenerateInputs returns an InvalidArgGroupError when they do
+// not fit. Groups only apply to freshly generated inputs: zero inputs and seeds are used
+// as given, and WithFixedArg still takes precedence. Calling WithArgGroup again for the
+// same index replaces attrs.
+//
+// Parameters:
+//   - index: The position of the first parameter of the group
+//   - attrs: The attributes generating the values of the whole group
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	bounds := attributes.IntegerAttributesImpl[int]{Min: 0, Max: 100}
+//	ft.WithFunction(func(start, end int) int { return end - start }).
+//	    WithArgGroup(0, attributes.IntervalAttributes{Bounds: bounds}) // start <= end
+func (mt *FTesting) WithArgGroup(index int, attrs a.Attributes) *FTesting {
+	if mt.argGroups == nil {
+		mt.argGroups = map[int]a.Attributes{}
+	}
+	mt.argGroups[index] = attrs
+	return mt
+}
+
+// WithUniqueArg makes the argument at index distinct across every tuple GenerateInputs
+// returns, rather than within one generated value like the Unique option of collection
+// attributes. This suits stateful-system fuzzing, where for example an ID inserted into a
+// shared store on every iteration must not collide with an earlier one. A value that was
+// already returned for the parameter, compared by its %#v formatting, is redrawn from the
+// attributes for the parameter type up to maxRetries times, after which GenerateInputs
+// returns a UniqueArgExhaustedError. Zero inputs and seeds count as returned values and are
+// redrawn too when they repeat. The parameter must not be held with WithFixedArg or
+// generated by WithArgGroup, or GenerateInputs returns an InvalidUniqueArgError. Calling
+// WithUniqueArg again for the same index replaces the budget and forgets the values seen.
+//
+// Parameters:
+//   - index: The position of the parameter whose values must not repeat
+//   - maxRetries: The number of redraws allowed per tuple; 0 uses 100
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	ft.WithFunction(store.Insert).WithUniqueArg(0, 0) // a fresh ID on every call
+func (mt *FTesting) WithUniqueArg(index int, maxRetries int) *FTesting {
+	if mt.uniqueArgs == nil {
+		mt.uniqueArgs, mt.seenArgs = map[int]int{}, map[int]map[string]bool{}
+	}
+	if maxRetries <= 0 {
+		maxRetries = defaultUniqueArgRetries
+	}
+	mt.uniqueArgs[index], mt.seenArgs[index] = maxRetries, map[string]bool{}
+	return mt
+}
+
+// WithErrorIsFailure makes ApplyFunction report ok=false when the function's last return
+// value is a non-nil error, surfacing it as a FunctionReturnedError. By default returned
+// values, errors included, are ignored and only input generation failures are reported.
+//
+// Parameters:
+//   - errorIsFailure: true to treat a returned error as a test failure
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	ft.WithFunction(strconv.Atoi).WithErrorIsFailure(true)
+//	ok, err := ft.ApplyFunction() // ok is false and err a FunctionReturnedError on bad input
+func (mt *FTesting) WithErrorIsFailure(errorIsFailure bool) *FTesting {
+	mt.errorIsFailure = errorIsFailure
+	return mt
+}
+
+// WithOracle makes ApplyFunction also call a reference implementation with the same
+// generated inputs and report ok=false with an OracleMismatchError holding both outputs
+// when they differ, as compared with reflect.DeepEqual. This brings differential testing
+// into the fuzzing loop, for example while migrating to a new implementation; see
+// pbtesting.OracleTest for the property-based counterpart. Each function receives its own
+// deep copy of the inputs returned by GenerateInputs, so that a function modifying its
+// arguments affects neither the other one nor the inputs reported in the error and
+// recorded by the input log set with WithInputLog.
+//
+// Parameters:
+//   - reference: A function with the same signature as the function under test, or nil to
+//     disable the comparison
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	ft := (&FTesting{}).WithFunction(newParser).WithOracle(legacyParser)
+//	ok, err := ft.ApplyFunction() // ok is false and err an OracleMismatchError on divergence
+func (mt *FTesting) WithOracle(reference any) *FTesting {
+	mt.oracle = reference
+	return mt
+}
+
 // WithAttributes sets custom attribute configurations for random value generation.
 // Attributes control how random values are generated for each type (ranges, constraints, etc.).
 //
@@ -135,9 +359,30 @@ func (mt *FTesting) WithAttributes(a a.AttributesStruct) *FTesting {
 //   - NoFunctionProvidedError: When no function has been set with WithFunction
 //   - NotAFunctionError: When the provided value is not a callable function
 //   - Attribute-related errors: When random value generation fails for a parameter type
+//   - attributes.ConstraintUnsatisfiableError: When a constrained generator runs out of retries
 //
 // The method automatically initializes default attributes if none were provided.
 //
+// For variadic functions the final parameter is expanded into between 0 and
+// maxVariadicArgs values of its element type, appended after the fixed parameters, so
+// the returned slice can be passed to the function as individual arguments.
+//
+// Functions without parameters are supported: GenerateInputs returns an empty, non-nil
+// slice, so ApplyFunction simply calls the function, which suits fuzzing generators
+// whose output must satisfy invariants.
+//
+// Parameters are generated in declaration order, each by its own GetRandomValue call on
+// the random source shared by the attributes package (see attributes.Seed). Parameters of the same type therefore share attributes
+// but not values: in func(a, b int), a and b are independent draws from the same range.
+//
+// When a seed corpus is configured with WithSeedCorpus, seeds and mutations of them are
+// returned for some calls instead of purely random inputs. Arguments held with
+// WithFixedArg are substituted next, and arguments made unique with WithUniqueArg are
+// redrawn last when they repeat.
+//
+// When an input log is configured with WithInputLog, every returned tuple is also
+// appended to it.
+//
 // Example usage:
 //
 //	ft.WithFunction(func(x int, y string) int { return x + len(y) })
@@ -153,30 +398,358 @@ func (mt *FTesting) GenerateInputs() ([]any, error) {
 	if mt.attributes == nil {
 		mt.attributes = a.NewFTAttributes()
 	}
+	mt.generated++
 	fType := reflect.TypeOf(mt.f)
-	args := make([]any, fType.NumIn())
+	args, grouped, err := mt.nextInputs(fType)
+	if err == nil {
+		err = mt.applyFixedArgs(fType, args)
+	}
+	if err == nil {
+		err = mt.applyUniqueArgs(fType, args, grouped)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mt.logInputs(args, nil)
+}
+
+// nextInputs returns the zero inputs, a seed, a mutated seed or freshly generated inputs
+// for the next call, depending on the configured options, together with the positions
+// filled by argument groups.
+func (mt *FTesting) nextInputs(fType reflect.Type) (args []any, grouped map[int]bool, err error) {
+	if mt.zeroFirst && mt.generated == 1 {
+		args, err = mt.ZeroInputs()
+		return args, nil, err
+	}
+	if mt.seedsReplayed < len(mt.seedCorpus) {
+		mt.seedsReplayed++
+		args, err = mt.replaySeed(fType, mt.seedsReplayed-1)
+		return args, nil, err
+	}
+	if len(mt.seedCorpus) > 0 && randomIndex(seedMutationOdds) == 0 {
+		args, err = mt.mutateSeed(fType, randomIndex(len(mt.seedCorpus)))
+		return args, nil, err
+	}
+	argTypes := make([]reflect.Type, 0, fType.NumIn())
 	for i := 0; i < fType.NumIn(); i++ {
-		argType := fType.In(i)
-		v, err := mt.attributes.GetAttributeGivenType(argType)
+		argTypes = append(argTypes, fType.In(i))
+	}
+	if fType.IsVariadic() {
+		elemType := argTypes[len(argTypes)-1].Elem()
+		argTypes = argTypes[:len(argTypes)-1]
+		for n := (a.IntegerAttributesImpl[int]{Min: 0, Max: maxVariadicArgs}).GetRandomValue().(int); n > 0; n-- {
+			argTypes = append(argTypes, elemType)
+		}
+	}
+	args = make([]any, len(argTypes))
+	if grouped, err = mt.generateArgGroups(argTypes, args); err != nil {
+		return nil, nil, err
+	}
+	for i, argType := range argTypes {
+		if _, fixed := mt.fixedArgs[i]; fixed || grouped[i] {
+			continue
+		}
+		if args[i], err = mt.generateValue(argType); err != nil {
+			return nil, nil, err
+		}
+	}
+	return args, grouped, nil
+}
+
+// generateArgGroups fills args with the values of the groups registered with
+// WithArgGroup, in index order, and reports the positions they cover.
+func (mt *FTesting) generateArgGroups(argTypes []reflect.Type, args []any) (grouped map[int]bool, err error) {
+	grouped = map[int]bool{}
+	for _, index := range slices.Sorted(maps.Keys(mt.argGroups)) {
+		attrs := mt.argGroups[index]
+		if index < 0 || index >= len(argTypes) {
+			return nil, InvalidArgGroupError{Index: index, Reason: fmt.Sprintf("function takes %d arguments", len(argTypes))}
+		}
+		value, err := mt.generate(attrs)
 		if err != nil {
 			return nil, err
 		}
-		args[i] = v.GetRandomValue()
+		v := reflect.ValueOf(value)
+		if !v.IsValid() || (v.Kind() != reflect.Array && v.Kind() != reflect.Slice) {
+			return nil, InvalidArgGroupError{Index: index, Reason: fmt.Sprintf("attributes generate %T, expected an array or slice", value)}
+		}
+		if index+v.Len() > len(argTypes) {
+			return nil, InvalidArgGroupError{Index: index, Reason: fmt.Sprintf("%d values exceed the %d arguments of the function", v.Len(), len(argTypes))}
+		}
+		for j := range v.Len() {
+			want, elem := argTypes[index+j], v.Index(j).Interface()
+			if reason := argMismatch(want, elem, j); reason != "" {
+				return nil, InvalidArgGroupError{Index: index, Reason: reason}
+			}
+			if grouped[index+j] {
+				return nil, InvalidArgGroupError{Index: index, Reason: fmt.Sprintf("argument %d belongs to another group", index+j)}
+			}
+			args[index+j], grouped[index+j] = convertArg(want, elem), true
+		}
+	}
+	return grouped, nil
+}
+
+// applyFixedArgs replaces the arguments registered with WithFixedArg, converted to the
+// parameter types. Fixed indices into the variadic parameter only apply when the call
+// has that many arguments.
+func (mt *FTesting) applyFixedArgs(fType reflect.Type, args []any) error {
+	for index, v := range mt.fixedArgs {
+		if index < 0 || (!fType.IsVariadic() && index >= fType.NumIn()) {
+			return InvalidFixedArgError{Index: index, Reason: fmt.Sprintf("function takes %d arguments", fType.NumIn())}
+		}
+		if index >= len(args) {
+			continue
+		}
+		want := paramType(fType, index)
+		if reason := argMismatch(want, v, index); reason != "" {
+			return InvalidFixedArgError{Index: index, Reason: reason}
+		}
+		args[index] = convertArg(want, v)
+	}
+	return nil
+}
+
+// applyUniqueArgs redraws the arguments registered with WithUniqueArg whose value was
+// already returned, in index order, and records the values they end up with. Unique
+// indices into the variadic parameter only apply when the call has that many arguments.
+func (mt *FTesting) applyUniqueArgs(fType reflect.Type, args []any, grouped map[int]bool) error {
+	for _, index := range slices.Sorted(maps.Keys(mt.uniqueArgs)) {
+		if index < 0 || (!fType.IsVariadic() && index >= fType.NumIn()) {
+			return InvalidUniqueArgError{Index: index, Reason: fmt.Sprintf("function takes %d arguments", fType.NumIn())}
+		}
+		if _, fixed := mt.fixedArgs[index]; fixed {
+			return InvalidUniqueArgError{Index: index, Reason: "the argument is fixed"}
+		}
+		if grouped[index] {
+			return InvalidUniqueArgError{Index: index, Reason: "the argument is generated by an argument group"}
+		}
+		if index >= len(args) {
+			continue
+		}
+		seen, key := mt.seenArgs[index], fmt.Sprintf("%#v", args[index])
+		for attempt := 0; seen[key]; attempt++ {
+			if attempt == mt.uniqueArgs[index] {
+				return UniqueArgExhaustedError{Index: index, Attempts: attempt}
+			}
+			v, err := mt.generateValue(paramType(fType, index))
+			if err != nil {
+				return err
+			}
+			args[index], key = v, fmt.Sprintf("%#v", v)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// generateValue generates one random value of type t with the configured attributes.
+func (mt *FTesting) generateValue(t reflect.Type) (any, error) {
+	v, err := mt.attributes.GetAttributeGivenType(t)
+	if err != nil {
+		return nil, err
+	}
+	return mt.generate(v)
+}
+
+// generate generates one value with attrs, counting it when MaxGeneratedElements cut it
+// short.
+func (mt *FTesting) generate(attrs a.Attributes) (any, error) {
+	value, report, err := a.GenerateValueReport(attrs)
+	if report.Truncated {
+		mt.truncated++
+	}
+	return value, err
+}
+
+// TruncatedInputs returns the number of values generated so far that were cut short by
+// the MaxGeneratedElements budget of the attributes, so that callers can tell how often
+// the inputs were smaller than configured.
+//
+// Returns:
+//   - uint: The number of truncated values
+//
+// Example usage:
+//
+//	attrs := attributes.NewFTAttributes()
+//	attrs.MaxGeneratedElements = 10
+//	ft.WithFunction(func(m [][]int) {}).WithAttributes(attrs)
+//	ft.GenerateInputs()
+//	if ft.TruncatedInputs() > 0 {
+//	    // m held fewer elements than its attributes asked for
+//	}
+func (mt *FTesting) TruncatedInputs() uint {
+	return mt.truncated
+}
+
+// replaySeed returns a copy of the seed at index after checking it against fType, with
+// values converted to the parameter types.
+func (mt *FTesting) replaySeed(fType reflect.Type, index int) ([]any, error) {
+	seed := mt.seedCorpus[index]
+	if err := checkSeed(fType, seed, index); err != nil {
+		return nil, err
+	}
+	args := make([]any, len(seed))
+	for i, v := range seed {
+		args[i] = convertArg(paramType(fType, i), v)
+	}
+	return args, nil
+}
+
+// convertArg converts v to want when it is neither nil nor assignable to it.
+func convertArg(want reflect.Type, v any) any {
+	if v != nil && !reflect.TypeOf(v).AssignableTo(want) {
+		return reflect.ValueOf(v).Convert(want).Interface()
+	}
+	return v
+}
+
+// mutateSeed returns a copy of the seed at index in which one randomly chosen argument
+// is replaced by a freshly generated value.
+func (mt *FTesting) mutateSeed(fType reflect.Type, index int) ([]any, error) {
+	args, err := mt.replaySeed(fType, index)
+	if err != nil || len(args) == 0 {
+		return args, err
+	}
+	i := randomIndex(len(args))
+	if _, fixed := mt.fixedArgs[i]; fixed {
+		return args, nil
+	}
+	if args[i], err = mt.generateValue(paramType(fType, i)); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// checkSeed reports an InvalidSeedError when seed does not have one value per fixed
+// parameter of fType (plus variadic arguments) of a compatible type, as decided by
+// argMismatch.
+func checkSeed(fType reflect.Type, seed []any, index int) error {
+	fixed := fType.NumIn()
+	if fType.IsVariadic() {
+		fixed--
+	}
+	if len(seed) < fixed || (!fType.IsVariadic() && len(seed) > fixed) {
+		return InvalidSeedError{Index: index, Reason: fmt.Sprintf("holds %d values, function takes %d", len(seed), fixed)}
+	}
+	for i, v := range seed {
+		if reason := argMismatch(paramType(fType, i), v, i); reason != "" {
+			return InvalidSeedError{Index: index, Reason: reason}
+		}
+	}
+	return nil
+}
+
+// argMismatch describes why v cannot be passed as argument i of type want, or returns
+// the empty string when it can. Besides values assignable to want, only numbers of the
+// same family as want (signed or unsigned integers, floats or complex numbers) are
+// accepted, and only when they fit want, so that no argument is converted lossily.
+func argMismatch(want reflect.Type, v any, i int) string {
+	if v == nil {
+		switch want.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface, reflect.Func, reflect.Chan:
+			return ""
+		}
+		return fmt.Sprintf("value %d is nil, parameter type %v cannot be nil", i, want)
+	}
+	got := reflect.TypeOf(v)
+	if got.AssignableTo(want) {
+		return ""
+	}
+	if family := utils.NumericFamily(got.Kind()); family == 0 || family != utils.NumericFamily(want.Kind()) {
+		return fmt.Sprintf("value %d has type %v, parameter type is %v", i, got, want)
+	}
+	if utils.Overflows(reflect.ValueOf(v), want) {
+		return fmt.Sprintf("value %d (%v) overflows parameter type %v", i, v, want)
+	}
+	return ""
+}
+
+// randomIndex returns a random int in [0, n) drawn from the shared random source.
+func randomIndex(n int) int {
+	return (a.IntegerAttributesImpl[int]{Min: 0, Max: n - 1}).GetRandomValue().(int)
+}
+
+// ZeroInputs returns the zero value of every fixed parameter of the configured test
+// function, typed so that it can be passed straight to the function (for example a nil
+// *T rather than an untyped nil). Variadic parameters receive no values.
+//
+// Returns:
+//   - []any: One zero value per fixed function parameter
+//   - error: NoFunctionProvidedError or NotAFunctionError when no valid function is set
+//
+// Example usage:
+//
+//	ft.WithFunction(func(n int, p *string, xs []int) {})
+//	inputs, _ := ft.ZeroInputs() // []any{0, (*string)(nil), []int(nil)}
+func (mt *FTesting) ZeroInputs() ([]any, error) {
+	if mt.f == nil {
+		return nil, &NoFunctionProvidedError{}
+	}
+	fType := reflect.TypeOf(mt.f)
+	if fType.Kind() != reflect.Func {
+		return nil, &NotAFunctionError{}
+	}
+	n := fType.NumIn()
+	if fType.IsVariadic() {
+		n--
+	}
+	args := make([]any, n)
+	for i := range args {
+		args[i] = reflect.Zero(fType.In(i)).Interface()
 	}
 	return args, nil
 }
 
+// Signature reports the parameter and return types of the configured test function, for
+// checking positional attributes or fixed arguments against it before fuzzing.
+//
+// Returns:
+//   - params: The parameter types in declaration order; the variadic parameter, if any,
+//     is last and reported as a slice type
+//   - returns: The return types in declaration order
+//   - variadic: Whether the last parameter is variadic
+//   - err: NoFunctionProvidedError or NotAFunctionError when no valid function is set
+//
+// Example usage:
+//
+//	ft.WithFunction(func(sep string, parts ...string) (string, error) { return "", nil })
+//	params, returns, variadic, _ := ft.Signature()
+//	// params: [string []string], returns: [string error], variadic: true
+func (mt *FTesting) Signature() (params []reflect.Type, returns []reflect.Type, variadic bool, err error) {
+	if mt.f == nil {
+		return nil, nil, false, &NoFunctionProvidedError{}
+	}
+	fType := reflect.TypeOf(mt.f)
+	if fType.Kind() != reflect.Func {
+		return nil, nil, false, &NotAFunctionError{}
+	}
+	params = make([]reflect.Type, fType.NumIn())
+	for i := range params {
+		params[i] = fType.In(i)
+	}
+	returns = make([]reflect.Type, fType.NumOut())
+	for i := range returns {
+		returns[i] = fType.Out(i)
+	}
+	return params, returns, fType.IsVariadic(), nil
+}
+
 // ApplyFunction generates random inputs and executes the configured test function
 // with those inputs. This method combines input generation and function execution
 // into a single operation.
 //
 // Returns:
 //   - bool: true if the function executed successfully, false otherwise
-//   - error: An error if input generation fails or if the function is not set
+//   - error: An error if input generation fails or if the function is not set, a
+//     FunctionReturnedError when WithErrorIsFailure is enabled and the function returned one,
+//     or, with WithOracle, an OracleSignatureMismatchError or an OracleMismatchError when
+//     the outputs of the function and the oracle differ
 //
-// The method uses reflection to call the function with generated arguments and
-// discards the return values. The focus is on whether the function can execute
-// without panicking, not on validating return values.
+// The method uses reflection to call the function with generated arguments and, by
+// default, discards the return values: the focus is on whether the function can execute
+// without panicking, so a returned error still yields ok=true. Enable WithErrorIsFailure
+// to treat a non-nil error in the last return value as a failure.
 //
 // Example usage:
 //
@@ -189,17 +762,138 @@ func (mt *FTesting) ApplyFunction() (bool, error) {
 	if mt.f == nil {
 		return false, fmt.Errorf("function is nil")
 	}
+	if mt.oracle != nil && reflect.TypeOf(mt.oracle) != reflect.TypeOf(mt.f) {
+		return false, OracleSignatureMismatchError{Function: reflect.TypeOf(mt.f), Oracle: reflect.TypeOf(mt.oracle)}
+	}
+	defer mt.CloseInputLog()
 	inputs, err := mt.GenerateInputs()
 	if err != nil {
 		return false, fmt.Errorf("failed to generate inputs: %w", err)
 	}
+	fValue := reflect.ValueOf(mt.f)
+	fInputs := inputs
+	if mt.oracle != nil {
+		fInputs = utils.DeepCopyAll(inputs)
+	}
+	args, err := callArgs(fValue.Type(), fInputs)
+	if err != nil {
+		return false, err
+	}
+	results := fValue.Call(args)
+	if mt.errorIsFailure {
+		if err := returnedError(results); err != nil {
+			return false, FunctionReturnedError{Inputs: inputs, Err: err}
+		}
+	}
+	if mt.oracle != nil {
+		oValue := reflect.ValueOf(mt.oracle)
+		oArgs, err := callArgs(oValue.Type(), utils.DeepCopyAll(inputs))
+		if err != nil {
+			return false, err
+		}
+		got, want := outputsOf(results), outputsOf(oValue.Call(oArgs))
+		if !reflect.DeepEqual(got, want) {
+			return false, OracleMismatchError{Inputs: inputs, Got: got, Want: want}
+		}
+	}
+	return true, nil
+}
+
+// CallResult records one call of the function under test made by ApplyFunctionResult.
+//
+// Fields:
+//   - Inputs: The generated arguments the function was called with
+//   - Outputs: Every value the function returned, in order (nil when it panicked)
+//   - Panic: A PanicError when the call panicked, nil otherwise
+type CallResult struct {
+	Inputs  []any
+	Outputs []any
+	Panic   error
+}
+
+// ApplyFunctionResult generates random inputs and executes the configured test function
+// like ApplyFunction, but returns the whole call instead of a success flag: the inputs,
+// all return values and any recovered panic, so that fuzzing a multi-return function can
+// inspect every output. A returned error is one of the return values and is not treated
+// as a failure, whatever WithErrorIsFailure is set to.
+//
+// Returns:
+//   - CallResult: The inputs, outputs and recovered panic of the call
+//   - error: An error if input generation fails or if the function is not set, or an
+//     ArgumentTypeError if the generated inputs do not convert to the parameter types
+//
+// Example usage:
+//
+//	ft.WithFunction(func(s string) (int, string, error) { ... })
+//	call, err := ft.ApplyFunctionResult()
+//	if err == nil && call.Panic == nil && call.Outputs[2] == nil {
+//	    n, rest := call.Outputs[0].(int), call.Outputs[1].(string)
+//	    // ...
+//	}
+func (mt *FTesting) ApplyFunctionResult() (CallResult, error) {
+	if mt.f == nil {
+		return CallResult{}, fmt.Errorf("function is nil")
+	}
+	defer mt.CloseInputLog()
+	inputs, err := mt.GenerateInputs()
+	if err != nil {
+		return CallResult{}, fmt.Errorf("failed to generate inputs: %w", err)
+	}
+	outputs, panicErr, err := callCapturing(mt.f, inputs)
+	if err != nil {
+		return CallResult{}, err
+	}
+	return CallResult{Inputs: inputs, Outputs: outputs, Panic: panicErr}, nil
+}
+
+// returnedError returns the last of results when it is a non-nil error, nil otherwise.
+func returnedError(results []reflect.Value) error {
+	if len(results) == 0 {
+		return nil
+	}
+	last := results[len(results)-1]
+	if !last.Type().Implements(errorType) {
+		return nil
+	}
+	switch last.Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		if last.IsNil() {
+			return nil
+		}
+	}
+	return last.Interface().(error)
+}
+
+// callArgs converts inputs to call arguments for a function of type fType. Untyped nil
+// inputs, such as the zero value of an interface parameter, become typed zero values,
+// and values of another type, such as the int generated for an int8 parameter, are
+// converted to the parameter type. An ArgumentTypeError is returned for a value that
+// does not convert.
+func callArgs(fType reflect.Type, inputs []any) ([]reflect.Value, error) {
 	args := make([]reflect.Value, len(inputs))
 	for i, input := range inputs {
+		want := paramType(fType, i)
 		args[i] = reflect.ValueOf(input)
+		switch {
+		case !args[i].IsValid():
+			args[i] = reflect.Zero(want)
+		case args[i].Type().AssignableTo(want):
+		case args[i].Type().ConvertibleTo(want):
+			args[i] = args[i].Convert(want)
+		default:
+			return nil, ArgumentTypeError{Index: i, Got: args[i].Type(), Want: want}
+		}
 	}
-	fValue := reflect.ValueOf(mt.f)
-	_ = fValue.Call(args)
-	return true, nil
+	return args, nil
+}
+
+// paramType returns the type expected for the i-th argument of fType. Arguments at or
+// past the variadic parameter of a variadic function take its element type.
+func paramType(fType reflect.Type, i int) reflect.Type {
+	if fType.IsVariadic() && i >= fType.NumIn()-1 {
+		return fType.In(fType.NumIn() - 1).Elem()
+	}
+	return fType.In(i)
 }
 
 // Verify executes the fuzz test and reports results using the configured testing.T instance.
@@ -236,3 +930,152 @@ func (mt *FTesting) Verify() {
 		mt.t.Error("Test Failed")
 	}
 }
+
+// AssertNoPanic fuzzes fn for the given number of iterations and fails the test if
+// any call panics. The failure message reports the generated inputs that caused the
+// panic together with the recovered value and stack trace. Input generation errors,
+// and an ArgumentTypeError for inputs that do not convert to the parameter types, also
+// fail the test and are never reported as a panic. A nil attrs uses the default
+// attributes.
+//
+// Example usage:
+//
+//	func TestParseNeverPanics(t *testing.T) {
+//	    ftesting.AssertNoPanic(t, parse, 1000, nil)
+//	}
+func AssertNoPanic(t *testing.T, fn any, iterations uint, attrs a.AttributesStruct) {
+	t.Helper()
+	if err := findPanic(fn, iterations, attrs); err != nil {
+		t.Errorf("AssertNoPanic failed: %s", err.Error())
+	}
+}
+
+// findPanic calls fn with freshly generated inputs up to iterations times and
+// returns a PanicError for the first call that panics, or the first input
+// generation error encountered.
+func findPanic(fn any, iterations uint, attrs a.AttributesStruct) error {
+	ft := &FTesting{}
+	ft.WithFunction(fn).WithAttributes(attrs).WithIterations(iterations)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			return err
+		}
+		if err := callRecovering(fn, inputs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertFinite fuzzes fn for the given number of iterations and fails the test if
+// any float or complex value it returns is NaN or infinite. Float inputs are drawn
+// with FiniteOnly enabled, so only the function itself can introduce a non-finite
+// value; the failure message reports the inputs that produced it. Input generation
+// errors, and an ArgumentTypeError for inputs that do not convert to the parameter
+// types, also fail the test. A nil attrs uses the default attributes.
+//
+// Example usage:
+//
+//	func TestNormalizeIsFinite(t *testing.T) {
+//	    ftesting.AssertFinite(t, normalize, 1000, nil)
+//	}
+func AssertFinite(t *testing.T, fn any, iterations uint, attrs a.AttributesStruct) {
+	t.Helper()
+	if err := findNonFinite(fn, iterations, attrs); err != nil {
+		t.Errorf("AssertFinite failed: %s", err.Error())
+	}
+}
+
+// findNonFinite calls fn with freshly generated inputs up to iterations times and
+// returns a NonFiniteOutputError for the first call that returns a NaN or infinite
+// value, or the first input generation error encountered.
+func findNonFinite(fn any, iterations uint, attrs a.AttributesStruct) error {
+	ft := &FTesting{}
+	ft.WithFunction(fn).WithAttributes(finiteFloats(attrs)).WithIterations(iterations)
+	fValue := reflect.ValueOf(fn)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			return err
+		}
+		args, err := callArgs(fValue.Type(), inputs)
+		if err != nil {
+			return err
+		}
+		for j, result := range fValue.Call(args) {
+			if !isFinite(result) {
+				return NonFiniteOutputError{Inputs: inputs, Index: j, Output: result.Interface()}
+			}
+		}
+	}
+	return nil
+}
+
+// finiteFloats returns attrs with FiniteOnly enabled on its float attributes when
+// they are a FloatAttributesImpl. Other attributes are returned unchanged.
+func finiteFloats(attrs a.AttributesStruct) a.AttributesStruct {
+	ftAttrs, ok := attrs.(a.FTAttributes)
+	if !ok {
+		return attrs
+	}
+	switch fa := ftAttrs.FloatAttr.(type) {
+	case a.FloatAttributesImpl[float64]:
+		fa.FiniteOnly = true
+		ftAttrs.FloatAttr = fa
+	case a.FloatAttributesImpl[float32]:
+		fa.FiniteOnly = true
+		ftAttrs.FloatAttr = fa
+	}
+	return ftAttrs
+}
+
+// isFinite reports whether v is neither NaN nor infinite. Values that are not floats
+// or complex numbers are always finite.
+func isFinite(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return !math.IsNaN(f) && !math.IsInf(f, 0)
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return !cmplx.IsNaN(c) && !cmplx.IsInf(c)
+	}
+	return true
+}
+
+// callRecovering calls fn with inputs, converting a panic into a PanicError. The
+// ArgumentTypeError of inputs that do not fit fn is returned as is.
+func callRecovering(fn any, inputs []any) error {
+	_, panicErr, err := callCapturing(fn, inputs)
+	if err != nil {
+		return err
+	}
+	return panicErr
+}
+
+// callCapturing calls fn with inputs and returns its return values, converting a panic
+// raised by fn into panicErr. Inputs that do not fit the parameters of fn are reported
+// as an ArgumentTypeError in err without calling fn.
+func callCapturing(fn any, inputs []any) (outputs []any, panicErr, err error) {
+	fValue := reflect.ValueOf(fn)
+	args, err := callArgs(fValue.Type(), inputs)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			outputs, panicErr = nil, PanicError{Inputs: inputs, Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return outputsOf(fValue.Call(args)), nil, nil
+}
+
+// outputsOf returns the values held by results.
+func outputsOf(results []reflect.Value) []any {
+	outputs := make([]any, len(results))
+	for i, result := range results {
+		outputs[i] = result.Interface()
+	}
+	return outputs
+}
@@ -55,3 +55,219 @@ type InputsGenerationError struct {
 func (ige InputsGenerationError) Error() string {
 	return fmt.Sprintf("error in input generation: %v", ige.err.Error())
 }
+
+// PanicError describes a panic raised by the function under test, together with
+// the inputs that triggered it and the stack trace captured at recovery.
+//
+// Fields:
+//   - Inputs: The generated arguments the function was called with
+//   - Value: The value passed to panic
+//   - Stack: The goroutine stack trace captured when the panic was recovered
+type PanicError struct {
+	Inputs []any
+	Value  any
+	Stack  []byte
+}
+
+func (pe PanicError) Error() string {
+	return fmt.Sprintf("function panicked with inputs %v: %v\n%s", pe.Inputs, pe.Value, pe.Stack)
+}
+
+// NonFiniteOutputError describes a NaN or infinite value returned by the function
+// under test, together with the inputs that produced it.
+//
+// Fields:
+//   - Inputs: The generated arguments the function was called with
+//   - Index: The position of the offending value among the return values
+//   - Output: The NaN or infinite value that was returned
+type NonFiniteOutputError struct {
+	Inputs []any
+	Index  int
+	Output any
+}
+
+func (ne NonFiniteOutputError) Error() string {
+	return fmt.Sprintf("function returned non-finite value %v at result %d with inputs %v", ne.Output, ne.Index, ne.Inputs)
+}
+
+// InputLogError is returned when an input log configured with WithInputLog cannot be
+// written, or when ReplayInputLog cannot read or decode one.
+//
+// Fields:
+//   - Path: The input log file
+//   - Err: The underlying I/O or JSON error
+type InputLogError struct {
+	Path string
+	Err  error
+}
+
+func (ile InputLogError) Error() string {
+	return fmt.Sprintf("input log %s: %v", ile.Path, ile.Err)
+}
+
+func (ile InputLogError) Unwrap() error { return ile.Err }
+
+// InputCountMismatchError is returned by ReplayInputLog when a logged record holds a
+// different number of inputs than the configured function accepts.
+//
+// Fields:
+//   - Iteration: The iteration number of the offending record
+//   - Got: The number of logged inputs
+//   - Want: The number of fixed parameters of the function
+type InputCountMismatchError struct {
+	Iteration uint
+	Got       int
+	Want      int
+}
+
+func (icme InputCountMismatchError) Error() string {
+	return fmt.Sprintf("record for iteration %d holds %d inputs, function takes %d", icme.Iteration, icme.Got, icme.Want)
+}
+
+// FunctionReturnedError is returned by ApplyFunction, when WithErrorIsFailure is enabled,
+// if the function under test returned a non-nil error as its last value. It is distinct
+// from the errors raised while generating inputs.
+//
+// Fields:
+//   - Inputs: The generated arguments the function was called with
+//   - Err: The error returned by the function
+type FunctionReturnedError struct {
+	Inputs []any
+	Err    error
+}
+
+func (fre FunctionReturnedError) Error() string {
+	return fmt.Sprintf("function returned an error with inputs %v: %v", fre.Inputs, fre.Err)
+}
+
+func (fre FunctionReturnedError) Unwrap() error { return fre.Err }
+
+// OracleMismatchError is returned by ApplyFunction, when an oracle is set with WithOracle,
+// if the function under test and the oracle returned different values for the same
+// inputs.
+//
+// Fields:
+//   - Inputs: The generated arguments both functions were called with
+//   - Got: The values returned by the function under test
+//   - Want: The values returned by the oracle
+type OracleMismatchError struct {
+	Inputs []any
+	Got    []any
+	Want   []any
+}
+
+func (ome OracleMismatchError) Error() string {
+	return fmt.Sprintf("function returned %v with inputs %v, oracle returned %v", ome.Got, ome.Inputs, ome.Want)
+}
+
+// OracleSignatureMismatchError is returned by ApplyFunction when the oracle set with
+// WithOracle does not have the same type as the function under test, so they cannot be
+// called with the same inputs.
+//
+// Fields:
+//   - Function: The type of the function under test
+//   - Oracle: The type of the oracle
+type OracleSignatureMismatchError struct {
+	Function reflect.Type
+	Oracle   reflect.Type
+}
+
+func (osme OracleSignatureMismatchError) Error() string {
+	return fmt.Sprintf("oracle must have the signature of the function, got %v and %v", osme.Oracle, osme.Function)
+}
+
+// InvalidSeedError is returned by GenerateInputs when a tuple given to WithSeedCorpus
+// does not fit the signature of the function under test.
+//
+// Fields:
+//   - Index: The position of the seed in the corpus
+//   - Reason: Why the seed was rejected
+type InvalidSeedError struct {
+	Index  int
+	Reason string
+}
+
+func (ise InvalidSeedError) Error() string {
+	return fmt.Sprintf("invalid seed %d: %s", ise.Index, ise.Reason)
+}
+
+// InvalidFixedArgError is returned by GenerateInputs when a value given to WithFixedArg
+// does not fit the signature of the function under test.
+//
+// Fields:
+//   - Index: The parameter position passed to WithFixedArg
+//   - Reason: Why the value was rejected
+type InvalidFixedArgError struct {
+	Index  int
+	Reason string
+}
+
+func (ifa InvalidFixedArgError) Error() string {
+	return fmt.Sprintf("invalid fixed argument %d: %s", ifa.Index, ifa.Reason)
+}
+
+// InvalidArgGroupError is returned by GenerateInputs when the values generated for a group
+// registered with WithArgGroup do not fit the signature of the function under test.
+//
+// Fields:
+//   - Index: The parameter position passed to WithArgGroup
+//   - Reason: Why the group was rejected
+type InvalidArgGroupError struct {
+	Index  int
+	Reason string
+}
+
+func (iag InvalidArgGroupError) Error() string {
+	return fmt.Sprintf("invalid argument group %d: %s", iag.Index, iag.Reason)
+}
+
+// InvalidUniqueArgError is returned by GenerateInputs when a parameter registered with
+// WithUniqueArg cannot be redrawn: it does not exist, is held with WithFixedArg or is
+// generated by an argument group.
+//
+// Fields:
+//   - Index: The parameter position passed to WithUniqueArg
+//   - Reason: Why the parameter cannot be made unique
+type InvalidUniqueArgError struct {
+	Index  int
+	Reason string
+}
+
+func (iua InvalidUniqueArgError) Error() string {
+	return fmt.Sprintf("invalid unique argument %d: %s", iua.Index, iua.Reason)
+}
+
+// UniqueArgExhaustedError is returned by GenerateInputs when every redraw of a parameter
+// registered with WithUniqueArg produced a value that was already returned, which usually
+// means the attributes for its type have run out of distinct values.
+//
+// Fields:
+//   - Index: The parameter position passed to WithUniqueArg
+//   - Attempts: The number of redraws that were made
+type UniqueArgExhaustedError struct {
+	Index    int
+	Attempts int
+}
+
+func (uae UniqueArgExhaustedError) Error() string {
+	return fmt.Sprintf("could not draw an unseen value for argument %d after %d attempts", uae.Index, uae.Attempts)
+}
+
+// ArgumentTypeError is returned when a generated input cannot be converted to the type
+// of the parameter it is passed as, which means the attributes generate values of the
+// wrong type. It is reported instead of calling the function, so that it is never
+// mistaken for a panic raised by the function under test.
+//
+// Fields:
+//   - Index: The position of the argument
+//   - Got: The type of the generated value
+//   - Want: The parameter type
+type ArgumentTypeError struct {
+	Index int
+	Got   reflect.Type
+	Want  reflect.Type
+}
+
+func (ate ArgumentTypeError) Error() string {
+	return fmt.Sprintf("argument %d has type %v, which does not convert to parameter type %v", ate.Index, ate.Got, ate.Want)
+}
@@ -1,7 +1,13 @@
 package ftesting
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/laiambryant/gotestutils/ftesting/attributes"
@@ -141,3 +147,741 @@ func TestInputsGenerationError(t *testing.T) {
 		t.Errorf("Expected error message '%s', got '%s'", expectedMessage2, actualMessage2)
 	}
 }
+
+func TestAssertNoPanic(t *testing.T) {
+	AssertNoPanic(t, sumFunc, 50, mta)
+	AssertNoPanic(t, sumFunc, 50, nil)
+}
+
+func TestFindPanic(t *testing.T) {
+	panicFunc := func(a int, b int) int {
+		if a > 50 {
+			panic("too big")
+		}
+		return a + b
+	}
+	err := findPanic(panicFunc, 500, mta)
+	var pe PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected PanicError, got %v", err)
+	}
+	if len(pe.Inputs) != 2 || pe.Inputs[0].(int) <= 50 {
+		t.Errorf("expected offending inputs to be reported, got %v", pe.Inputs)
+	}
+	if pe.Value != "too big" {
+		t.Errorf("expected panic value 'too big', got %v", pe.Value)
+	}
+	if len(pe.Stack) == 0 {
+		t.Error("expected a stack trace to be captured")
+	}
+	if !strings.Contains(pe.Error(), "too big") {
+		t.Errorf("expected error message to contain panic value, got %q", pe.Error())
+	}
+}
+
+func TestFindPanicGenerationError(t *testing.T) {
+	if err := findPanic("not a function", 10, mta); err == nil {
+		t.Error("expected an error for a non-function value")
+	}
+	if err := findPanic(sumFunc, 0, mta); err != nil {
+		t.Errorf("expected no error with zero iterations, got %v", err)
+	}
+}
+
+func TestFindPanicConvertsArguments(t *testing.T) {
+	var got []int8
+	if err := findPanic(func(n int8) { got = append(got, n) }, 20, nil); err != nil {
+		t.Fatalf("expected generated ints to be converted to int8, got %v", err)
+	}
+	if len(got) != 20 {
+		t.Errorf("expected 20 calls, got %d", len(got))
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.RegisterType(reflect.TypeFor[int8](), attributes.StringAttributes{MinLen: 1, MaxLen: 3})
+	err := findPanic(func(int8) {}, 5, attrs)
+	var ate ArgumentTypeError
+	if !errors.As(err, &ate) || errors.As(err, new(PanicError)) {
+		t.Fatalf("expected an ArgumentTypeError rather than a PanicError, got %v", err)
+	}
+	if ate.Index != 0 || ate.Got != reflect.TypeFor[string]() || ate.Want != reflect.TypeFor[int8]() {
+		t.Errorf("unexpected error details: %+v", ate)
+	}
+}
+
+func TestAssertFinite(t *testing.T) {
+	half := func(x float64) float64 { return x / 2 }
+	AssertFinite(t, half, 100, nil)
+	AssertFinite(t, half, 100, attributes.FTAttributes{
+		FloatAttr: attributes.FloatAttributesImpl[float64]{Min: -10, Max: 10, AllowNaN: true, AllowInf: true},
+	})
+}
+
+func TestFindNonFinite(t *testing.T) {
+	inverse := func(x int) (float64, error) {
+		if x > 50 {
+			return math.Inf(1), nil
+		}
+		return 1 / float64(x), nil
+	}
+	err := findNonFinite(inverse, 500, mta)
+	var ne NonFiniteOutputError
+	if !errors.As(err, &ne) {
+		t.Fatalf("expected NonFiniteOutputError, got %v", err)
+	}
+	if len(ne.Inputs) != 1 || ne.Inputs[0].(int) <= 50 {
+		t.Errorf("expected offending inputs to be reported, got %v", ne.Inputs)
+	}
+	if ne.Index != 0 || !math.IsInf(ne.Output.(float64), 1) {
+		t.Errorf("expected +Inf at result 0, got %v at result %d", ne.Output, ne.Index)
+	}
+	nan := func(int) complex128 { return complex(math.NaN(), 0) }
+	if err := findNonFinite(nan, 10, mta); !errors.As(err, &ne) {
+		t.Errorf("expected NonFiniteOutputError for a NaN complex result, got %v", err)
+	}
+	if err := findNonFinite("not a function", 10, mta); err == nil {
+		t.Error("expected an error for a non-function value")
+	}
+	halve := func(x float32, n int16) float32 { return x / 2 * float32(n) }
+	if err := findNonFinite(halve, 50, nil); err != nil {
+		t.Errorf("expected generated values to be converted to float32 and int16, got %v", err)
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.RegisterType(reflect.TypeFor[float32](), attributes.BoolAttributes{})
+	if err := findNonFinite(halve, 5, attrs); !errors.As(err, new(ArgumentTypeError)) {
+		t.Errorf("expected an ArgumentTypeError for bools passed as float32, got %v", err)
+	}
+}
+
+func TestFTestingGenerateInputsIndependentParameters(t *testing.T) {
+	mt := FTesting{}
+	mt.WithFunction(sumFunc).WithAttributes(mta)
+	const iterations = 2000
+	equal, less := 0, 0
+	for i := 0; i < iterations; i++ {
+		inputs, err := mt.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		a, b := inputs[0].(int), inputs[1].(int)
+		if a == b {
+			equal++
+		}
+		if a < b {
+			less++
+		}
+	}
+	// With 91 possible values, a == b should happen about 1% of the time and a < b about half.
+	if equal > iterations/20 {
+		t.Errorf("parameters of the same type were equal in %d of %d iterations", equal, iterations)
+	}
+	if less < iterations*4/10 || less > iterations*6/10 {
+		t.Errorf("expected a < b in about half of the iterations, got %d of %d", less, iterations)
+	}
+}
+
+func TestFTestingTruncatedInputs(t *testing.T) {
+	mt := FTesting{}
+	mt.WithFunction(func(xs []int, n int) {}).WithAttributes(attributes.FTAttributes{
+		SliceAttr:            attributes.SliceAttributes{MinLen: 10, MaxLen: 10, ElementAttrs: attributes.IntegerAttributesImpl[int]{Min: 1, Max: 9}},
+		IntegerAttr:          attributes.IntegerAttributesImpl[int]{Min: 1, Max: 9},
+		MaxGeneratedElements: 4,
+	})
+	for i := 0; i < 3; i++ {
+		if _, err := mt.GenerateInputs(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := mt.TruncatedInputs(); got != 3 {
+		t.Errorf("expected the 3 truncated slices to be counted, got %d", got)
+	}
+}
+
+func TestFTestingGenerateInputsVariadic(t *testing.T) {
+	variadic := func(prefix string, nums ...int) int { return len(prefix) + len(nums) }
+	mt := FTesting{}
+	mt.WithFunction(variadic).WithAttributes(attributes.FTAttributes{
+		StringAttr:  attributes.StringAttributes{MinLen: 1, MaxLen: 5},
+		IntegerAttr: attributes.IntegerAttributesImpl[int]{Min: 10, Max: 100},
+	})
+	seen := map[int]bool{}
+	for i := 0; i < 200; i++ {
+		inputs, err := mt.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := inputs[0].(string); !ok {
+			t.Fatalf("expected the fixed parameter first, got %T", inputs[0])
+		}
+		for _, in := range inputs[1:] {
+			if n, ok := in.(int); !ok || n < 10 || n > 100 {
+				t.Fatalf("expected variadic ints in [10, 100], got %v", in)
+			}
+		}
+		seen[len(inputs)-1] = true
+	}
+	if !seen[0] || !seen[maxVariadicArgs] {
+		t.Errorf("expected both zero and %d variadic args to be generated, got counts %v", maxVariadicArgs, seen)
+	}
+}
+
+func TestFTestingApplyFunctionVariadic(t *testing.T) {
+	calls := 0
+	variadic := func(nums ...int) { calls++ }
+	mt := FTesting{}
+	mt.WithFunction(variadic)
+	for i := 0; i < 20; i++ {
+		if ok, err := mt.ApplyFunction(); !ok || err != nil {
+			t.Fatalf("unexpected failure: %v", err)
+		}
+	}
+	if calls != 20 {
+		t.Errorf("expected 20 calls, got %d", calls)
+	}
+}
+
+func TestFTestingWithZeroFirst(t *testing.T) {
+	fn := func(n int, s string, p *int, xs []int, m map[string]int) {}
+	mt := FTesting{}
+	mt.WithFunction(fn).WithZeroFirst(true)
+	inputs, err := mt.GenerateInputs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []any{0, "", (*int)(nil), []int(nil), map[string]int(nil)}
+	if !reflect.DeepEqual(inputs, expected) {
+		t.Errorf("expected typed zero values %#v, got %#v", expected, inputs)
+	}
+	if ok, err := mt.ApplyFunction(); !ok || err != nil {
+		t.Errorf("expected random iterations to follow, got %v", err)
+	}
+}
+
+func TestFTestingZeroInputsCalledWithTypedNils(t *testing.T) {
+	var gotErr error = errors.New("not called")
+	fn := func(e error, nums ...int) {
+		if len(nums) != 0 {
+			panic("expected no variadic values")
+		}
+		gotErr = e
+	}
+	if err := callRecovering(fn, mustZeroInputs(t, fn)); err != nil {
+		t.Fatalf("unexpected panic: %v", err)
+	}
+	if gotErr != nil {
+		t.Errorf("expected a nil error argument, got %v", gotErr)
+	}
+}
+
+func mustZeroInputs(t *testing.T, fn any) []any {
+	t.Helper()
+	inputs, err := (&FTesting{f: fn}).ZeroInputs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return inputs
+}
+
+func TestFTestingZeroInputsErrors(t *testing.T) {
+	if _, err := (&FTesting{}).ZeroInputs(); err == nil {
+		t.Error("expected an error without a function")
+	}
+	if _, err := (&FTesting{f: 42}).ZeroInputs(); err == nil {
+		t.Error("expected an error for a non-function")
+	}
+}
+
+func TestFTestingSignature(t *testing.T) {
+	ft := (&FTesting{}).WithFunction(func(sep string, n int, parts ...string) (string, error) { return "", nil })
+	params, returns, variadic, err := ft.Signature()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantParams := []reflect.Type{reflect.TypeOf(""), reflect.TypeOf(0), reflect.TypeOf([]string{})}
+	wantReturns := []reflect.Type{reflect.TypeOf(""), reflect.TypeOf((*error)(nil)).Elem()}
+	if !reflect.DeepEqual(params, wantParams) || !reflect.DeepEqual(returns, wantReturns) || !variadic {
+		t.Errorf("unexpected signature: %v %v %v", params, returns, variadic)
+	}
+	params, returns, variadic, _ = (&FTesting{}).WithFunction(func() {}).Signature()
+	if len(params) != 0 || len(returns) != 0 || variadic {
+		t.Errorf("expected an empty signature, got %v %v %v", params, returns, variadic)
+	}
+	var nfp *NoFunctionProvidedError
+	if _, _, _, err := (&FTesting{}).Signature(); !errors.As(err, &nfp) {
+		t.Errorf("expected NoFunctionProvidedError, got %v", err)
+	}
+	var naf *NotAFunctionError
+	if _, _, _, err := (&FTesting{f: 42}).Signature(); !errors.As(err, &naf) {
+		t.Errorf("expected NotAFunctionError, got %v", err)
+	}
+}
+
+func TestFTestingGenerateInputsConstraintUnsatisfiable(t *testing.T) {
+	attrs := attributes.NewFTAttributes()
+	attrs.StringAttr = attributes.StringAttributes{MinLen: 3, MaxLen: 3, AllowedRunes: []rune("ab"), UniqueChars: true}
+	attrs.Generation = attributes.GenerationConfig{MaxRetries: 5}
+	ft := (&FTesting{}).WithFunction(func(s string) {}).WithAttributes(attrs)
+	_, err := ft.GenerateInputs()
+	var cue attributes.ConstraintUnsatisfiableError
+	if !errors.As(err, &cue) || cue.Attempts != 5 {
+		t.Errorf("expected ConstraintUnsatisfiableError after 5 attempts, got %v", err)
+	}
+}
+
+func TestFTestingGenerateInputsAny(t *testing.T) {
+	ft := (&FTesting{}).WithFunction(func(v any) {})
+	for range 20 {
+		if _, err := ft.GenerateInputs(); err != nil {
+			t.Fatalf("expected JSON-like values for an any parameter, got %v", err)
+		}
+		if ok, err := ft.ApplyFunction(); !ok || err != nil {
+			t.Fatalf("expected the function to accept generated values, got %v", err)
+		}
+	}
+}
+
+type point struct {
+	X, Y int
+}
+
+func TestFTestingPointerToNamedStruct(t *testing.T) {
+	attrs := attributes.NewFTAttributes()
+	attrs.PointerAttr = attributes.PointerAttributes{Depth: 1, Inner: attributes.StructAttributes{
+		FieldAttrs: map[string]any{
+			"X": attributes.IntegerAttributesImpl[int]{Min: 1, Max: 10},
+			"Y": attributes.IntegerAttributesImpl[int]{Min: 1, Max: 10},
+		},
+	}}
+	var got []*point
+	ft := (&FTesting{}).WithFunction(func(p *point) { got = append(got, p) }).WithAttributes(attrs)
+	for range 20 {
+		if ok, err := ft.ApplyFunction(); !ok || err != nil {
+			t.Fatalf("expected the function to accept generated *point values, got %v", err)
+		}
+	}
+	if len(got) != 20 {
+		t.Fatalf("expected the function to be called 20 times, got %d", len(got))
+	}
+	for _, p := range got {
+		if p == nil || p.X < 1 || p.X > 10 || p.Y < 1 || p.Y > 10 {
+			t.Fatalf("expected a *point with populated fields, got %+v", p)
+		}
+	}
+}
+
+func (p point) String() string { return fmt.Sprintf("(%d, %d)", p.X, p.Y) }
+
+type label struct {
+	Text string
+}
+
+func (l *label) String() string { return l.Text }
+
+func TestFTestingInterfaceParameter(t *testing.T) {
+	attrs := attributes.NewFTAttributes()
+	attrs.StructAttr = attributes.StructAttributes{
+		TargetType: reflect.TypeOf(point{}),
+		FieldAttrs: map[string]any{"X": attributes.IntegerAttributesImpl[int]{Min: 1, Max: 10}},
+	}
+	var got []string
+	ft := (&FTesting{}).WithFunction(func(s fmt.Stringer) { got = append(got, s.String()) }).WithAttributes(attrs)
+	for range 20 {
+		if ok, err := ft.ApplyFunction(); !ok || err != nil {
+			t.Fatalf("expected the function to accept generated fmt.Stringer values, got %v", err)
+		}
+	}
+	for _, s := range got {
+		if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ", 0)") {
+			t.Fatalf("expected the point String method to be called, got %q", s)
+		}
+	}
+	attrs.PointerAttr = attributes.PointerAttributes{Depth: 1, Inner: attributes.StructAttributes{
+		TargetType: reflect.TypeOf(label{}),
+		FieldAttrs: map[string]any{"Text": attributes.StringAttributes{MinLen: 3, MaxLen: 3}},
+	}}
+	attrs.StructAttr = attributes.StructAttributes{}
+	got = nil
+	for range 20 {
+		if ok, err := ft.WithAttributes(attrs).ApplyFunction(); !ok || err != nil {
+			t.Fatalf("expected the function to accept generated *label values, got %v", err)
+		}
+	}
+	for _, s := range got {
+		if len(s) != 3 {
+			t.Fatalf("expected the label String method to be called, got %q", s)
+		}
+	}
+}
+
+func TestFTestingWithErrorIsFailure(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(n int) (int, error) {
+		if n > 5 {
+			return 0, errTooLarge
+		}
+		return n, nil
+	}
+	large := attributes.NewFTAttributes(attributes.WithIntegerRange(6, 10))
+	ft := (&FTesting{}).WithFunction(check).WithAttributes(large)
+	if ok, err := ft.ApplyFunction(); !ok || err != nil {
+		t.Errorf("expected returned errors to be ignored by default, got ok=%v err=%v", ok, err)
+	}
+	ok, err := ft.WithErrorIsFailure(true).ApplyFunction()
+	var fre FunctionReturnedError
+	if ok || !errors.As(err, &fre) || !errors.Is(err, errTooLarge) || len(fre.Inputs) != 1 {
+		t.Errorf("expected a FunctionReturnedError wrapping the returned error, got ok=%v err=%v", ok, err)
+	}
+	small := attributes.NewFTAttributes(attributes.WithIntegerRange(0, 5))
+	if ok, err := ft.WithAttributes(small).ApplyFunction(); !ok || err != nil {
+		t.Errorf("expected a nil returned error to pass, got ok=%v err=%v", ok, err)
+	}
+	if ok, err := (&FTesting{}).WithFunction(func(*int) error { return nil }).WithErrorIsFailure(true).ApplyFunction(); !ok || err != nil {
+		t.Errorf("expected a nil error interface to pass, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestFTestingWithOracle(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	buggyDouble := func(n int) int { return n + 2 }
+	large := attributes.NewFTAttributes(attributes.WithIntegerRange(3, 10))
+	if ok, err := (&FTesting{}).WithFunction(double).WithOracle(double).WithAttributes(large).ApplyFunction(); !ok || err != nil {
+		t.Errorf("expected matching outputs to pass, got ok=%v err=%v", ok, err)
+	}
+	ok, err := (&FTesting{}).WithFunction(buggyDouble).WithOracle(double).WithAttributes(large).ApplyFunction()
+	var ome OracleMismatchError
+	if ok || !errors.As(err, &ome) || len(ome.Inputs) != 1 {
+		t.Fatalf("expected an OracleMismatchError, got ok=%v err=%v", ok, err)
+	}
+	n := ome.Inputs[0].(int)
+	if !reflect.DeepEqual(ome.Got, []any{n + 2}) || !reflect.DeepEqual(ome.Want, []any{n * 2}) {
+		t.Errorf("expected both outputs for input %d, got %v and %v", n, ome.Got, ome.Want)
+	}
+	ok, err = (&FTesting{}).WithFunction(double).WithOracle(func(n int64) int64 { return n }).ApplyFunction()
+	if ok || !errors.As(err, new(OracleSignatureMismatchError)) {
+		t.Errorf("expected an OracleSignatureMismatchError, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestFTestingWithOracleMutatingFunction(t *testing.T) {
+	sortFirst := func(s []int) int {
+		slices.Sort(s)
+		return s[0]
+	}
+	first := func(s []int) int { return s[0] }
+	attrs := attributes.NewFTAttributes()
+	attrs.SliceAttr = attributes.SliceAttributes{MinLen: 3, MaxLen: 5, ElementAttrs: attributes.IntegerAttributesImpl[int]{Min: 0, Max: 1000}}
+	ft := (&FTesting{}).WithFunction(sortFirst).WithOracle(first).WithAttributes(attrs)
+	mismatches := 0
+	for range 50 {
+		ok, err := ft.ApplyFunction()
+		if ok {
+			continue
+		}
+		var ome OracleMismatchError
+		if !errors.As(err, &ome) {
+			t.Fatalf("expected an OracleMismatchError, got %v", err)
+		}
+		s := ome.Inputs[0].([]int)
+		if !reflect.DeepEqual(ome.Want, []any{s[0]}) || !reflect.DeepEqual(ome.Got, []any{slices.Min(s)}) {
+			t.Fatalf("expected the reported inputs to be the generated ones, got %+v", ome)
+		}
+		mismatches++
+	}
+	if mismatches == 0 {
+		t.Error("expected the oracle to see the inputs before the function sorted them")
+	}
+}
+
+func TestFTestingApplyFunctionResult(t *testing.T) {
+	errNegative := errors.New("negative")
+	describe := func(n int) (int, string, error) {
+		if n < 0 {
+			return 0, "", errNegative
+		}
+		return n * 2, fmt.Sprint(n), nil
+	}
+	for _, bounds := range [][2]int{{0, 10}, {-10, -1}} {
+		attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(bounds[0], bounds[1]))
+		call, err := (&FTesting{}).WithFunction(describe).WithAttributes(attrs).WithErrorIsFailure(true).ApplyFunctionResult()
+		if err != nil || call.Panic != nil || len(call.Inputs) != 1 {
+			t.Fatalf("unexpected call %+v (err %v)", call, err)
+		}
+		input := call.Inputs[0].(int)
+		if input < bounds[0] || input > bounds[1] {
+			t.Fatalf("expected an input in %v, got %d", bounds, input)
+		}
+		n, s, e := describe(input)
+		want := []any{n, s, nil}
+		if e != nil {
+			want[2] = e
+		}
+		if !reflect.DeepEqual(call.Outputs, want) {
+			t.Errorf("expected outputs %#v for input %v, got %#v", want, input, call.Outputs)
+		}
+		if bounds[1] < 0 && call.Outputs[2] != errNegative {
+			t.Errorf("expected the error output for negative input %d, got %#v", input, call.Outputs)
+		}
+	}
+	call, err := (&FTesting{}).WithFunction(func(n int8) int8 { return -n }).ApplyFunctionResult()
+	if err != nil || call.Panic != nil || len(call.Outputs) != 1 || call.Outputs[0] != -int8(call.Inputs[0].(int)) {
+		t.Errorf("expected the input converted to int8, got %+v (err %v)", call, err)
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.RegisterType(reflect.TypeFor[int8](), attributes.StringAttributes{MinLen: 1, MaxLen: 3})
+	call, err = (&FTesting{}).WithFunction(func(n int8) int8 { return -n }).WithAttributes(attrs).ApplyFunctionResult()
+	if !errors.As(err, new(ArgumentTypeError)) || call.Panic != nil {
+		t.Errorf("expected an ArgumentTypeError rather than a recovered panic, got %+v (err %v)", call, err)
+	}
+	call, err = (&FTesting{}).WithFunction(func(int) (int, error) { panic("boom") }).ApplyFunctionResult()
+	var pe PanicError
+	if err != nil || !errors.As(call.Panic, &pe) || pe.Value != "boom" || call.Outputs != nil || len(call.Inputs) != 1 {
+		t.Errorf("expected the panic to be recovered, got %+v (err %v)", call, err)
+	}
+	if _, err := (&FTesting{}).ApplyFunctionResult(); err == nil {
+		t.Error("expected an error without a function")
+	}
+}
+
+func TestFTestingWithSeedCorpus(t *testing.T) {
+	ft := (&FTesting{}).WithFunction(func(n int, s string, xs ...float64) {}).
+		WithSeedCorpus([]any{1, "seed"}, []any{2, "other", float32(3), 4.5})
+	first, err := ft.GenerateInputs()
+	if err != nil || !reflect.DeepEqual(first, []any{1, "seed"}) {
+		t.Fatalf("expected the first seed to be replayed, got %#v (err %v)", first, err)
+	}
+	if second, _ := ft.GenerateInputs(); !reflect.DeepEqual(second, []any{2, "other", float64(3), 4.5}) {
+		t.Errorf("expected the second seed converted to the parameter types, got %#v", second)
+	}
+	mutated := 0
+	for range 400 {
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if s := inputs[1].(string); s == "seed" || s == "other" {
+			mutated++
+		}
+	}
+	if mutated == 0 || mutated > 200 {
+		t.Errorf("expected roughly one in four tuples to derive from a seed, got %d of 400", mutated)
+	}
+	if ok, err := ft.ApplyFunction(); !ok || err != nil {
+		t.Errorf("expected generated tuples to be callable, got %v", err)
+	}
+}
+
+func TestFTestingWithSeedCorpusInvalid(t *testing.T) {
+	fn := func(n int, p *int) {}
+	for _, seed := range [][]any{{1}, {1, nil, 2}, {"one", nil}, {nil, nil}} {
+		_, err := (&FTesting{}).WithFunction(fn).WithSeedCorpus(seed).GenerateInputs()
+		var ise InvalidSeedError
+		if !errors.As(err, &ise) || ise.Index != 0 {
+			t.Errorf("expected InvalidSeedError for seed %v, got %v", seed, err)
+		}
+	}
+	if _, err := (&FTesting{}).WithFunction(fn).WithSeedCorpus([]any{1, nil}).GenerateInputs(); err != nil {
+		t.Errorf("expected nil to be accepted for a pointer parameter, got %v", err)
+	}
+	lossy := func(a [2]int, s string, n int, b int8) {}
+	for _, seed := range [][]any{
+		{[]int{1, 2}, "s", 1, int8(1)},
+		{[2]int{}, 65, 1, int8(1)},
+		{[2]int{}, "s", 1.5, int8(1)},
+		{[2]int{}, "s", 1, 300},
+		{[2]int{}, "s", uint(1), int8(1)},
+	} {
+		_, err := (&FTesting{}).WithFunction(lossy).WithSeedCorpus(seed).GenerateInputs()
+		if !errors.As(err, new(InvalidSeedError)) {
+			t.Errorf("expected InvalidSeedError for seed %v, got %v", seed, err)
+		}
+	}
+	inputs, err := (&FTesting{}).WithFunction(lossy).WithSeedCorpus([]any{[2]int{1, 2}, "s", int64(7), -5}).GenerateInputs()
+	if err != nil || inputs[2] != 7 || inputs[3] != int8(-5) {
+		t.Errorf("expected integers to be converted within their family, got %v (err %v)", inputs, err)
+	}
+}
+
+func TestFTestingWithFixedArg(t *testing.T) {
+	type celsius float64
+	fn := func(n int, s string, c celsius) {}
+	ft := (&FTesting{}).WithFunction(fn).WithFixedArg(1, "fixed").WithFixedArg(2, 21.5).WithZeroFirst(true)
+	for i := range 20 {
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if inputs[1] != "fixed" || inputs[2] != celsius(21.5) {
+			t.Fatalf("expected fixed arguments in call %d, got %v", i, inputs)
+		}
+	}
+	variadic := (&FTesting{}).WithFunction(func(xs ...int) {}).WithFixedArg(0, int8(3))
+	for range 20 {
+		inputs, err := variadic.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(inputs) > 0 && inputs[0] != 3 {
+			t.Fatalf("expected the first variadic argument to be fixed, got %v", inputs)
+		}
+	}
+}
+
+func TestFTestingWithFixedArgNotGenerated(t *testing.T) {
+	ctx := context.Background()
+	ft := (&FTesting{}).WithFunction(func(ctx context.Context, n int) {}).WithFixedArg(0, ctx).WithSeedCorpus([]any{ctx, 1})
+	for range 20 {
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			t.Fatalf("expected a fixed context.Context not to be generated, got %v", err)
+		}
+		if inputs[0] != ctx {
+			t.Fatalf("expected the fixed context, got %v", inputs)
+		}
+	}
+}
+
+func TestFTestingWithArgGroup(t *testing.T) {
+	type day int
+	interval := attributes.IntervalAttributes{Bounds: attributes.IntegerAttributesImpl[int]{Min: 0, Max: 100}}
+	ft := (&FTesting{}).WithFunction(func(label string, start, end day) {}).WithArgGroup(1, interval)
+	for range 50 {
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		start, ok := inputs[1].(day)
+		if end := inputs[2].(day); !ok || start > end {
+			t.Fatalf("expected an ordered pair of days, got %v", inputs)
+		}
+		if _, ok := inputs[0].(string); !ok {
+			t.Fatalf("expected the first argument to be generated as usual, got %v", inputs)
+		}
+	}
+	held := (&FTesting{}).WithFunction(func(start, end int) {}).WithArgGroup(0, interval).WithFixedArg(1, 200)
+	if inputs, err := held.GenerateInputs(); err != nil || inputs[1] != 200 {
+		t.Errorf("expected fixed arguments to take precedence, got %v (err %v)", inputs, err)
+	}
+	var iag InvalidArgGroupError
+	for _, ft := range []*FTesting{
+		(&FTesting{}).WithFunction(func(start int) {}).WithArgGroup(0, interval),
+		(&FTesting{}).WithFunction(func(start, end []int) {}).WithArgGroup(0, interval),
+		(&FTesting{}).WithFunction(func(start, end int) {}).WithArgGroup(0, attributes.IntegerAttributesImpl[int]{}),
+		(&FTesting{}).WithFunction(func(start, end int) {}).WithArgGroup(2, interval),
+		(&FTesting{}).WithFunction(func(a, b, c int) {}).WithArgGroup(0, interval).WithArgGroup(1, interval),
+		(&FTesting{}).WithFunction(func(start, end string) {}).WithArgGroup(0, interval),
+		(&FTesting{}).WithFunction(func(start, end float64) {}).WithArgGroup(0, interval),
+		(&FTesting{}).WithFunction(func(start, end int8) {}).WithArgGroup(0, attributes.IntervalAttributes{Bounds: attributes.IntegerAttributesImpl[int]{Min: 200, Max: 300}}),
+	} {
+		if _, err := ft.GenerateInputs(); !errors.As(err, &iag) {
+			t.Errorf("expected InvalidArgGroupError, got %v", err)
+		}
+	}
+}
+
+func TestFTestingWithUniqueArg(t *testing.T) {
+	ids := attributes.NewFTAttributes(attributes.WithIntegerRange(1, 20))
+	ft := (&FTesting{}).WithFunction(func(id int, name string) {}).WithAttributes(ids).WithZeroFirst(true).WithUniqueArg(0, 1000)
+	seen := map[int]bool{}
+	for range 21 { // the zero input and the 20 IDs of the range
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if id := inputs[0].(int); seen[id] {
+			t.Fatalf("expected distinct IDs, got %d twice", id)
+		} else {
+			seen[id] = true
+		}
+	}
+	var uae UniqueArgExhaustedError
+	if _, err := ft.GenerateInputs(); !errors.As(err, &uae) || uae.Index != 0 || uae.Attempts != 1000 {
+		t.Errorf("expected UniqueArgExhaustedError once every ID was used, got %v", err)
+	}
+	if _, err := ft.WithUniqueArg(0, 0).GenerateInputs(); err != nil {
+		t.Errorf("expected WithUniqueArg to forget the values seen, got %v", err)
+	}
+	interval := attributes.IntervalAttributes{Bounds: attributes.IntegerAttributesImpl[int]{Min: 0, Max: 100}}
+	var iua InvalidUniqueArgError
+	for _, ft := range []*FTesting{
+		(&FTesting{}).WithFunction(func(id int) {}).WithUniqueArg(1, 0),
+		(&FTesting{}).WithFunction(func(id int) {}).WithFixedArg(0, 1).WithUniqueArg(0, 0),
+		(&FTesting{}).WithFunction(func(start, end int) {}).WithArgGroup(0, interval).WithUniqueArg(1, 0),
+	} {
+		if _, err := ft.GenerateInputs(); !errors.As(err, &iua) {
+			t.Errorf("expected InvalidUniqueArgError, got %v", err)
+		}
+	}
+}
+
+func TestFTestingIotaEnumParameter(t *testing.T) {
+	type color int
+	const (
+		red color = iota
+		green
+		blue
+	)
+	attrs := attributes.NewFTAttributes()
+	attrs.RegisterType(reflect.TypeOf(red), attributes.IotaEnumAttributes{Type: reflect.TypeOf(red), Values: []int64{int64(red), int64(green), int64(blue)}})
+	var got []color
+	ft := (&FTesting{}).WithFunction(func(c color) { got = append(got, c) }).WithAttributes(attrs)
+	for range 50 {
+		if ok, err := ft.ApplyFunction(); !ok || err != nil {
+			t.Fatalf("unexpected failure: ok=%v err=%v", ok, err)
+		}
+	}
+	for _, c := range got {
+		if c < red || c > blue {
+			t.Fatalf("expected only declared colors, got %v", got)
+		}
+	}
+}
+
+func TestFTestingContextParameter(t *testing.T) {
+	inputs, err := (&FTesting{}).WithFunction(func(n int, ctx context.Context) {}).GenerateInputs()
+	if err != nil {
+		t.Fatalf("expected context.Context parameters to be generated, got %v", err)
+	}
+	if inputs[1] != context.Background() {
+		t.Errorf("expected context.Background() by default, got %v", inputs[1])
+	}
+}
+
+func TestFTestingWithFixedArgInvalid(t *testing.T) {
+	var ifa InvalidFixedArgError
+	if _, err := (&FTesting{}).WithFunction(sumFunc).WithFixedArg(2, 1).GenerateInputs(); !errors.As(err, &ifa) || ifa.Index != 2 {
+		t.Errorf("expected InvalidFixedArgError for an out-of-range index, got %v", err)
+	}
+	if _, err := (&FTesting{}).WithFunction(sumFunc).WithFixedArg(0, "x").GenerateInputs(); !errors.As(err, &ifa) {
+		t.Errorf("expected InvalidFixedArgError for a mistyped value, got %v", err)
+	}
+	for _, ft := range []*FTesting{
+		(&FTesting{}).WithFunction(func(a [2]int) {}).WithFixedArg(0, []int{1, 2}),
+		(&FTesting{}).WithFunction(func(s string) {}).WithFixedArg(0, 65),
+		(&FTesting{}).WithFunction(func(n int) {}).WithFixedArg(0, 2.5),
+		(&FTesting{}).WithFunction(func(n uint8) {}).WithFixedArg(0, uint(256)),
+	} {
+		if _, err := ft.GenerateInputs(); !errors.As(err, &ifa) || ifa.Index != 0 {
+			t.Errorf("expected InvalidFixedArgError for a lossy conversion, got %v", err)
+		}
+	}
+	want := "invalid fixed argument 0: value 0 has type string, parameter type is int"
+	if err := (InvalidFixedArgError{Index: 0, Reason: "value 0 has type string, parameter type is int"}); err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestFTestingZeroParameterFunction(t *testing.T) {
+	calls := 0
+	ft := (&FTesting{}).WithFunction(func() float64 { calls++; return 1 }).WithZeroFirst(true)
+	for range 3 {
+		inputs, err := ft.GenerateInputs()
+		if err != nil || inputs == nil || len(inputs) != 0 {
+			t.Fatalf("expected an empty, non-nil input slice, got %#v (%v)", inputs, err)
+		}
+		if ok, err := ft.ApplyFunction(); !ok || err != nil {
+			t.Fatalf("expected the function to be called, got %v", err)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
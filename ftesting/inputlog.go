@@ -0,0 +1,171 @@
+package ftesting
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"reflect"
+)
+
+// inputLogRecord is the JSON object written to an input log for every generated input
+// tuple, one object per line.
+type inputLogRecord struct {
+	Iteration uint              `json:"iteration"`
+	Inputs    []json.RawMessage `json:"inputs"`
+}
+
+// WithInputLog makes every call to GenerateInputs append the generated inputs to the
+// file at path, creating it if needed. Each line is a JSON object holding the 1-based
+// iteration number and the inputs, for example {"iteration":3,"inputs":[42,"abc"]}.
+// The seed of the shared random source is not recorded; use ReplayInputLog to feed the
+// logged inputs back to the function instead.
+//
+// Writes are buffered. ApplyFunction and Verify flush and close the log before returning,
+// even when the function under test panics; callers driving GenerateInputs directly
+// should defer CloseInputLog. The log is reopened in append mode on the next write.
+//
+// Inputs must be encodable as JSON: complex numbers, NaN and infinite floats and maps
+// with float keys cannot be logged and make GenerateInputs return an InputLogError.
+//
+// Parameters:
+//   - path: The file to append inputs to; an empty path disables logging
+//
+// Returns the FTesting instance for method chaining.
+//
+// Example usage:
+//
+//	ft := (&FTesting{}).WithFunction(parse).WithInputLog("testdata/parse.inputs.jsonl")
+//	defer ft.CloseInputLog()
+//	for range 1000 {
+//	    inputs, err := ft.GenerateInputs()
+//	    // ...
+//	}
+func (mt *FTesting) WithInputLog(path string) *FTesting {
+	mt.CloseInputLog()
+	mt.inputLogPath = path
+	return mt
+}
+
+// CloseInputLog flushes buffered input log records and closes the log file. It is a
+// no-op when no log is open.
+//
+// Returns an InputLogError when flushing or closing the file fails.
+func (mt *FTesting) CloseInputLog() error {
+	if mt.inputLogFile == nil {
+		return nil
+	}
+	flushErr := mt.inputLog.Flush()
+	closeErr := mt.inputLogFile.Close()
+	mt.inputLog, mt.inputLogFile = nil, nil
+	if flushErr != nil {
+		return InputLogError{Path: mt.inputLogPath, Err: flushErr}
+	}
+	if closeErr != nil {
+		return InputLogError{Path: mt.inputLogPath, Err: closeErr}
+	}
+	return nil
+}
+
+// logInputs appends inputs to the input log when one is configured. It takes the results
+// of an input generation call and passes them through, replacing them with an
+// InputLogError when the record cannot be written.
+func (mt *FTesting) logInputs(inputs []any, err error) ([]any, error) {
+	if err != nil || mt.inputLogPath == "" {
+		return inputs, err
+	}
+	record := inputLogRecord{Iteration: mt.generated, Inputs: make([]json.RawMessage, len(inputs))}
+	for i, input := range inputs {
+		if record.Inputs[i], err = json.Marshal(input); err != nil {
+			return nil, InputLogError{Path: mt.inputLogPath, Err: err}
+		}
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, InputLogError{Path: mt.inputLogPath, Err: err}
+	}
+	if mt.inputLogFile == nil {
+		file, err := os.OpenFile(mt.inputLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, InputLogError{Path: mt.inputLogPath, Err: err}
+		}
+		mt.inputLogFile, mt.inputLog = file, bufio.NewWriter(file)
+	}
+	if _, err = mt.inputLog.Write(append(line, '\n')); err != nil {
+		return nil, InputLogError{Path: mt.inputLogPath, Err: err}
+	}
+	return inputs, nil
+}
+
+// ReplayInputLog reads an input log written by WithInputLog and decodes every record into
+// arguments for the configured function, in the order they were logged. Each value is
+// decoded into the type of its parameter, so the returned tuples can be passed to the
+// function just like the result of GenerateInputs.
+//
+// Parameters:
+//   - path: The input log to read
+//
+// Returns:
+//   - [][]any: One input tuple per logged record
+//   - error: NoFunctionProvidedError or NotAFunctionError when no valid function is set,
+//     or an InputLogError when the file cannot be read or a record does not match the
+//     function's parameters
+//
+// Example usage:
+//
+//	ft := (&FTesting{}).WithFunction(parse)
+//	tuples, err := ft.ReplayInputLog("testdata/parse.inputs.jsonl")
+//	for _, inputs := range tuples {
+//	    parse(inputs[0].(string))
+//	}
+func (mt *FTesting) ReplayInputLog(path string) ([][]any, error) {
+	if mt.f == nil {
+		return nil, &NoFunctionProvidedError{}
+	}
+	fType := reflect.TypeOf(mt.f)
+	if fType.Kind() != reflect.Func {
+		return nil, &NotAFunctionError{k: fType.Kind()}
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, InputLogError{Path: path, Err: err}
+	}
+	defer file.Close()
+	var tuples [][]any
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		inputs, err := decodeInputLogRecord(fType, scanner.Bytes())
+		if err != nil {
+			return nil, InputLogError{Path: path, Err: err}
+		}
+		tuples = append(tuples, inputs)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, InputLogError{Path: path, Err: err}
+	}
+	return tuples, nil
+}
+
+// decodeInputLogRecord decodes one input log line into arguments for a function of type fType.
+func decodeInputLogRecord(fType reflect.Type, line []byte) ([]any, error) {
+	var record inputLogRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		return nil, err
+	}
+	fixed := fType.NumIn()
+	if fType.IsVariadic() {
+		fixed--
+	}
+	if len(record.Inputs) < fixed || (!fType.IsVariadic() && len(record.Inputs) > fixed) {
+		return nil, InputCountMismatchError{Iteration: record.Iteration, Got: len(record.Inputs), Want: fixed}
+	}
+	inputs := make([]any, len(record.Inputs))
+	for i, raw := range record.Inputs {
+		v := reflect.New(paramType(fType, i))
+		if err := json.Unmarshal(raw, v.Interface()); err != nil {
+			return nil, err
+		}
+		inputs[i] = v.Elem().Interface()
+	}
+	return inputs, nil
+}
@@ -0,0 +1,103 @@
+package ftesting
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
+)
+
+func TestInputLogRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inputs.jsonl")
+	f := func(n int, s string, xs []int, p *int) {}
+	ft := (&FTesting{}).WithFunction(f).WithZeroFirst(true).WithInputLog(path)
+	var generated [][]any
+	for range 5 {
+		inputs, err := ft.GenerateInputs()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		generated = append(generated, inputs)
+	}
+	if err := ft.CloseInputLog(); err != nil {
+		t.Fatalf("unexpected close error: %v", err)
+	}
+	content, _ := os.ReadFile(path)
+	if !strings.HasPrefix(string(content), `{"iteration":1,"inputs":[0,"",null,null]}`) {
+		t.Errorf("unexpected log format: %s", content)
+	}
+	replayed, err := (&FTesting{}).WithFunction(f).ReplayInputLog(path)
+	if err != nil {
+		t.Fatalf("unexpected replay error: %v", err)
+	}
+	if len(replayed) != len(generated) {
+		t.Fatalf("expected %d replayed tuples, got %d", len(generated), len(replayed))
+	}
+	for i := 1; i < len(generated); i++ {
+		if !reflect.DeepEqual(replayed[i], generated[i]) {
+			t.Errorf("tuple %d: expected %v, got %v", i, generated[i], replayed[i])
+		}
+	}
+	if replayed[0][3] != (*int)(nil) {
+		t.Errorf("expected a typed nil pointer, got %#v", replayed[0][3])
+	}
+}
+
+func TestInputLogAppendsAndClosesOnPanic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inputs.jsonl")
+	ft := (&FTesting{}).WithFunction(func(n int) { panic("boom") }).WithInputLog(path)
+	for range 2 {
+		func() {
+			defer func() { recover() }()
+			ft.ApplyFunction()
+		}()
+	}
+	if ft.inputLogFile != nil {
+		t.Error("expected the input log to be closed after a panic")
+	}
+	replayed, err := ft.ReplayInputLog(path)
+	if err != nil || len(replayed) != 2 {
+		t.Errorf("expected both panicking inputs to be logged, got %v (err %v)", replayed, err)
+	}
+}
+
+func TestInputLogVariadic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inputs.jsonl")
+	f := func(prefix string, xs ...int) {}
+	ft := (&FTesting{}).WithFunction(f).WithInputLog(path)
+	generated, err := ft.GenerateInputs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ft.CloseInputLog()
+	replayed, err := ft.ReplayInputLog(path)
+	if err != nil || !reflect.DeepEqual(replayed, [][]any{generated}) {
+		t.Errorf("expected %v, got %v (err %v)", generated, replayed, err)
+	}
+}
+
+func TestInputLogErrors(t *testing.T) {
+	dir := t.TempDir()
+	attrs := attributes.NewFTAttributes()
+	ft := (&FTesting{}).WithFunction(func(c complex128) {}).WithAttributes(attrs).WithInputLog(filepath.Join(dir, "c.jsonl"))
+	var logErr InputLogError
+	if _, err := ft.GenerateInputs(); !errors.As(err, &logErr) {
+		t.Errorf("expected InputLogError for a complex input, got %v", err)
+	}
+	if _, err := ft.ReplayInputLog(filepath.Join(dir, "missing.jsonl")); !errors.As(err, &logErr) || !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected InputLogError wrapping ErrNotExist, got %v", err)
+	}
+	path := filepath.Join(dir, "two.jsonl")
+	os.WriteFile(path, []byte(`{"iteration":1,"inputs":[1,2]}`+"\n"), 0644)
+	var countErr InputCountMismatchError
+	if _, err := (&FTesting{}).WithFunction(func(n int) {}).ReplayInputLog(path); !errors.As(err, &countErr) || countErr.Got != 2 || countErr.Want != 1 {
+		t.Errorf("expected InputCountMismatchError, got %v", err)
+	}
+	if _, err := (&FTesting{}).ReplayInputLog(path); err == nil {
+		t.Error("expected an error without a function")
+	}
+}
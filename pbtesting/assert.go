@@ -0,0 +1,106 @@
+package pbtesting
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
+	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
+)
+
+// maxReportedFailures bounds the number of failing results detailed in the message of
+// Assert, so that a property failing on every iteration does not flood the test output.
+const maxReportedFailures = 3
+
+// Assert runs the test and reports any failure on the testing.T set with WithT, replacing
+// the usual Run, FilterPBTTestOut and t.Errorf sequence. A Run error is reported as is;
+// failing results are reported in a single t.Errorf message listing, for the first few
+// of them, the inputs (shrunk when WithShrinking is enabled, along with the generated
+// inputs), the output and the names of the failing predicates, followed by the seed that
+// reproduces the run with WithSeed.
+//
+// Note: when no seed was set with WithSeed, Assert reseeds the package-wide random source
+// of the attributes package from the current time, so that it can report a seed. This
+// changes the values generated afterwards by every other test in the process, including
+// tests running in parallel; set a seed to keep Assert from touching the source.
+//
+// Like Verify, Assert does nothing without a testing.T set with WithT: it returns false
+// without running the test.
+//
+// Returns true if the run succeeded and every result passed.
+//
+// Example usage:
+//
+//	NewPBTest(abs).WithIterations(1000).WithPredicates(nonNegative).WithShrinking(true).WithT(t).Assert()
+//	// pbtesting_test.go:42: property failed for 1 of 1000 results (seed 1712345678, rerun with WithSeed(1712345678)):
+//	//     inputs: [-9223372036854775808] (shrunk from [-9223372036854775808])
+//	//     output: -9223372036854775808
+//	//     failed predicates: nonNegative
+func (pbt *PBTest) Assert() bool {
+	if pbt.t == nil {
+		return false
+	}
+	pbt.t.Helper()
+	seed := pbt.seed
+	if !pbt.seeded {
+		seed = time.Now().UnixNano()
+		attributes.Seed(seed)
+	}
+	results, err := pbt.Run()
+	if err != nil {
+		pbt.t.Errorf("property test could not run: %v", err)
+		return false
+	}
+	failures := FilterPBTTestOut(results)
+	passed, failed := pbt.ResultCounts()
+	if failed == 0 {
+		return true
+	}
+	pbt.t.Errorf("%s", failureReport(failures, int(failed), int(passed+failed), seed))
+	return false
+}
+
+// failureReport formats the message Assert reports for failed out of total results of a
+// run made with seed, detailing the first of the retained failures.
+func failureReport(failures []PBTestOut, failed, total int, seed int64) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "property failed for %d of %d results (seed %d, rerun with WithSeed(%d)):", failed, total, seed, seed)
+	for i, failure := range failures {
+		if i == maxReportedFailures {
+			break
+		}
+		fmt.Fprintf(&b, "\n    inputs: %v", failure.Inputs)
+		if failure.ShrunkFrom != nil {
+			fmt.Fprintf(&b, " (shrunk from %v)", failure.ShrunkFrom)
+		}
+		fmt.Fprintf(&b, "\n    output: %v", failure.Output)
+		if failure.Mutated != nil {
+			fmt.Fprintf(&b, "\n    mutated arguments: %v", failure.Mutated)
+			continue
+		}
+		if failure.RerunOutput != nil || failure.Predicates == nil {
+			fmt.Fprintf(&b, "\n    nondeterministic: rerun returned %v", failure.RerunOutput)
+			continue
+		}
+		fmt.Fprintf(&b, "\n    failed predicates: %s", predicateNames(failure.Predicates))
+	}
+	if shown := min(len(failures), maxReportedFailures); failed > shown {
+		fmt.Fprintf(&b, "\n    ... and %d more", failed-shown)
+	}
+	return b.String()
+}
+
+// predicateNames lists predicates by their String method, or by their type when they
+// have none.
+func predicateNames(preds []p.Predicate) string {
+	names := make([]string, len(preds))
+	for i, pred := range preds {
+		if s, ok := pred.(fmt.Stringer); ok {
+			names[i] = s.String()
+		} else {
+			names[i] = fmt.Sprintf("%T", pred)
+		}
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,59 @@
+package pbtesting
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
+)
+
+func TestAssert_Passing(t *testing.T) {
+	ok := NewPBTest(f1).WithIterations(20).WithPredicates(mockPredicate{shouldPass: true, name: "always"}).WithT(t).Assert()
+	if !ok {
+		t.Error("expected Assert to succeed when every predicate passes")
+	}
+}
+
+func TestAssert_WithoutT(t *testing.T) {
+	ran := false
+	test := NewPBTest(func(n int) int { ran = true; return n }).WithPredicates(mockPredicate{shouldPass: true, name: "always"})
+	if test.Assert() || ran {
+		t.Errorf("expected Assert to return false without running when no testing.T is set, ran=%v", ran)
+	}
+}
+
+func TestWithSeed_Reproducible(t *testing.T) {
+	test := NewPBTest(f1).WithIterations(10).WithPredicates(mockPredicate{shouldPass: true}).WithSeed(42)
+	first, _ := test.Run()
+	second, _ := test.Run()
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("expected runs with the same seed to generate the same inputs, got %v and %v", first, second)
+	}
+}
+
+func TestFailureReport(t *testing.T) {
+	failures := []PBTestOut{
+		{Inputs: []any{1}, ShrunkFrom: []any{93}, Output: 1, Predicates: []p.Predicate{mockPredicate{name: "even"}, p.WithinPercent{Expected: 5, Percent: 1}}},
+		{Inputs: []any{2}, Output: 2, RerunOutput: 3},
+		{Inputs: []any{3}, Output: 3, Predicates: []p.Predicate{mockPredicateForAttrTest{}}},
+		{Inputs: []any{4}, Output: 4, Predicates: []p.Predicate{mockPredicate{name: "even"}}},
+		{Inputs: []any{5}, Output: 5, Predicates: []p.Predicate{mockPredicate{name: "even"}}},
+	}
+	report := failureReport(failures, len(failures), 100, 7)
+	for _, want := range []string{
+		"property failed for 5 of 100 results (seed 7, rerun with WithSeed(7)):",
+		"inputs: [1] (shrunk from [93])",
+		"failed predicates: even, " + p.WithinPercent{Expected: 5, Percent: 1}.String(),
+		"nondeterministic: rerun returned 3",
+		"failed predicates: pbtesting.mockPredicateForAttrTest",
+		"... and 2 more",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected the report to contain %q, got:\n%s", want, report)
+		}
+	}
+	if strings.Contains(report, "inputs: [4]") {
+		t.Errorf("expected at most %d failures to be detailed, got:\n%s", maxReportedFailures, report)
+	}
+}
@@ -0,0 +1,113 @@
+package pbtesting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// exportedResult is the machine-readable form of a PBTestOut written by ExportResults.
+type exportedResult struct {
+	Inputs           []any    `json:"inputs"`
+	Output           any      `json:"output"`
+	RerunOutput      any      `json:"rerun_output,omitempty"`
+	Ok               bool     `json:"ok"`
+	FailedPredicates []string `json:"failed_predicates,omitempty"`
+}
+
+// ExportResults writes the results of a run to w for offline analysis or as a CI
+// artifact, replacing ad-hoc t.Logf dumps with a structured file.
+//
+// Supported formats:
+//   - "json": A JSON array with one object per result holding inputs, output,
+//     rerun_output (determinism failures only), ok and failed_predicates
+//   - "csv": A header row followed by one row per result with the columns inputs,
+//     output, ok and failed_predicates; inputs and output are JSON-encoded and failing
+//     predicates are separated by semicolons
+//
+// Predicates are named by their String method when they have one. Inputs and outputs
+// that cannot be encoded as JSON (NaN, complex numbers, functions, ...) are written as
+// their fmt %v representation.
+//
+// Parameters:
+//   - results: The results of a Run
+//   - w: The destination writer
+//   - format: "json" or "csv"
+//
+// Returns UnsupportedExportFormatError for any other format, or the error of the
+// underlying writer.
+//
+// Example usage:
+//
+//	f, _ := os.Create("results.csv")
+//	defer f.Close()
+//	if err := ExportResults(results, f, "csv"); err != nil {
+//	    t.Fatal(err)
+//	}
+func ExportResults(results []PBTestOut, w io.Writer, format string) error {
+	records := make([]exportedResult, len(results))
+	for i, result := range results {
+		records[i] = exportRecord(result)
+	}
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case "csv":
+		return writeResultsCSV(records, w)
+	}
+	return UnsupportedExportFormatError{Format: format}
+}
+
+// exportRecord converts a result into its exported form.
+func exportRecord(result PBTestOut) exportedResult {
+	record := exportedResult{
+		Inputs:      make([]any, len(result.Inputs)),
+		Output:      jsonSafe(result.Output),
+		RerunOutput: jsonSafe(result.RerunOutput),
+		Ok:          result.Passed(),
+	}
+	for i, input := range result.Inputs {
+		record.Inputs[i] = jsonSafe(input)
+	}
+	for _, pred := range result.Predicates {
+		record.FailedPredicates = append(record.FailedPredicates, fmt.Sprint(pred))
+	}
+	return record
+}
+
+// jsonSafe returns v when it can be encoded as JSON and its %v representation otherwise.
+func jsonSafe(v any) any {
+	if _, err := json.Marshal(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return v
+}
+
+// writeResultsCSV writes records as CSV with a header row.
+func writeResultsCSV(records []exportedResult, w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"inputs", "output", "ok", "failed_predicates"}); err != nil {
+		return err
+	}
+	for _, record := range records {
+		inputs, err := json.Marshal(record.Inputs)
+		if err != nil {
+			return err
+		}
+		output, err := json.Marshal(record.Output)
+		if err != nil {
+			return err
+		}
+		row := []string{string(inputs), string(output), strconv.FormatBool(record.Ok), strings.Join(record.FailedPredicates, ";")}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,75 @@
+package pbtesting
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
+)
+
+func exportFixture() []PBTestOut {
+	return []PBTestOut{
+		{Inputs: []any{2, "ab"}, Output: 4, Ok: true},
+		{Inputs: []any{math.NaN()}, Output: -1, Predicates: []p.Predicate{mockPredicate{name: "nonNegative"}}, Ok: false},
+	}
+}
+
+func TestExportResults_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportResults(exportFixture(), &buf, "json"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("expected valid JSON, got %v:\n%s", err, buf.String())
+	}
+	if len(got) != 2 || got[0]["ok"] != true || got[1]["ok"] != false {
+		t.Fatalf("unexpected records: %v", got)
+	}
+	if inputs := got[0]["inputs"].([]any); inputs[0] != float64(2) || inputs[1] != "ab" {
+		t.Errorf("unexpected inputs: %v", inputs)
+	}
+	if got[1]["inputs"].([]any)[0] != "NaN" {
+		t.Errorf("expected NaN to be exported as text, got %v", got[1]["inputs"])
+	}
+	if preds := got[1]["failed_predicates"].([]any); len(preds) != 1 || preds[0] != "nonNegative" {
+		t.Errorf("unexpected failed predicates: %v", preds)
+	}
+	if _, found := got[0]["failed_predicates"]; found {
+		t.Error("expected passing results to omit failed_predicates")
+	}
+}
+
+func TestExportResults_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportResults(exportFixture(), &buf, "csv"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("expected valid CSV, got %v", err)
+	}
+	want := [][]string{
+		{"inputs", "output", "ok", "failed_predicates"},
+		{`[2,"ab"]`, "4", "true", ""},
+		{`["NaN"]`, "-1", "false", "nonNegative"},
+	}
+	for i := range want {
+		for j := range want[i] {
+			if rows[i][j] != want[i][j] {
+				t.Errorf("row %d column %d: expected %q, got %q", i, j, want[i][j], rows[i][j])
+			}
+		}
+	}
+}
+
+func TestExportResults_UnsupportedFormat(t *testing.T) {
+	err := ExportResults(nil, &bytes.Buffer{}, "xml")
+	if !errors.As(err, new(UnsupportedExportFormatError)) {
+		t.Errorf("expected UnsupportedExportFormatError, got %v", err)
+	}
+}
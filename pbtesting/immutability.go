@@ -0,0 +1,108 @@
+package pbtesting
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/laiambryant/gotestutils/utils"
+)
+
+// applyChecked calls the function under test with inputs. With WithImmutabilityCheck the
+// call receives a deep copy of inputs instead, which is compared with inputs afterwards,
+// and the indices of the arguments the call changed are returned (nil when none was).
+// inputs themselves are never handed to the function then, so that reported and shrunk
+// inputs are the generated ones.
+func (pbt *PBTest) applyChecked(inputs []any) (outs any, mutated []int) {
+	if !pbt.immutability {
+		outs, _ = pbt.applyFunction(inputs...)
+		return outs, nil
+	}
+	args := utils.DeepCopyAll(inputs)
+	outs, _ = pbt.applyFunction(args...)
+	for i := range inputs {
+		if !sameValue(reflect.ValueOf(inputs[i]), reflect.ValueOf(args[i]), map[[2]uintptr]bool{}) {
+			mutated = append(mutated, i)
+		}
+	}
+	return outs, mutated
+}
+
+// sameValue reports whether a and b are deeply equal the way reflect.DeepEqual decides it,
+// except that NaN equals NaN, so that arguments holding NaN are not reported as mutated,
+// and functions are equal when they are the same function, as utils.DeepCopy shares them.
+// visited holds the pairs of pointers being compared, to stop on cycles.
+func sameValue(a, b reflect.Value, visited map[[2]uintptr]bool) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Slice:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+	case reflect.Pointer, reflect.Map:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		pair := [2]uintptr{a.Pointer(), b.Pointer()}
+		if visited[pair] {
+			return true
+		}
+		visited[pair] = true
+	}
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return sameFloat(a.Float(), b.Float())
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		return sameFloat(real(x), real(y)) && sameFloat(imag(x), imag(y))
+	case reflect.Pointer, reflect.Interface:
+		return sameValue(a.Elem(), b.Elem(), visited)
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !sameValue(a.Index(i), b.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			bv := b.MapIndex(iter.Key())
+			if !bv.IsValid() || !sameValue(iter.Value(), bv, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !sameValue(a.Field(i), b.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	}
+	return a.Uint() == b.Uint()
+}
+
+// sameFloat reports whether x and y are equal or both NaN.
+func sameFloat(x, y float64) bool {
+	return x == y || (math.IsNaN(x) && math.IsNaN(y))
+}
@@ -0,0 +1,118 @@
+package pbtesting
+
+import (
+	"reflect"
+
+	"github.com/laiambryant/gotestutils/ftesting"
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
+	"github.com/laiambryant/gotestutils/utils"
+)
+
+// OracleTest is a differential (oracle) test: it calls a candidate implementation and
+// a trusted reference implementation with the same generated inputs and reports every
+// input on which their outputs differ. This expresses "two implementations agree",
+// which a single-function PBTest cannot.
+//
+// Fields:
+//   - candidate: The implementation under test
+//   - reference: The implementation whose outputs are taken as correct
+//   - iterations: Number of input tuples to generate
+//
+// Example usage:
+//
+//	mismatches, err := NewOracleTest(sumFormula, sumIterative).WithIterations(500).Run()
+type OracleTest struct {
+	candidate  any
+	reference  any
+	iterations uint
+}
+
+// OracleMismatch records an input on which the candidate and the reference disagreed.
+//
+// Fields:
+//   - Inputs: The generated arguments both functions were called with
+//   - Candidate: The output of the candidate ([]any for multiple return values)
+//   - Reference: The output of the reference ([]any for multiple return values)
+type OracleMismatch struct {
+	Inputs    []any
+	Candidate any
+	Reference any
+}
+
+// NewOracleTest creates an oracle test comparing candidate against reference. Both must
+// be functions with identical signatures.
+//
+// Returns an OracleTest configured with 1 iteration by default.
+//
+// Example usage:
+//
+//	test := NewOracleTest(fastSort, slowSort).WithIterations(1000)
+func NewOracleTest(candidate, reference any) *OracleTest {
+	return &OracleTest{candidate: candidate, reference: reference, iterations: 1}
+}
+
+// WithIterations sets the number of input tuples to generate.
+//
+// Returns the OracleTest instance for method chaining.
+func (ot *OracleTest) WithIterations(n uint) *OracleTest { ot.iterations = n; return ot }
+
+// Run executes the oracle test with default attributes.
+//
+// See RunWithAttributes.
+func (ot *OracleTest) Run() ([]OracleMismatch, error) {
+	return ot.RunWithAttributes(nil)
+}
+
+// RunWithAttributes generates inputs with a, applies both functions to each input tuple
+// and compares their outputs with reflect.DeepEqual. Each function receives its own deep
+// copy of the inputs, so that a function modifying its arguments affects neither the
+// other function nor the reported Inputs.
+//
+// Parameters:
+//   - a: Attributes used to generate inputs; nil uses the defaults
+//
+// Returns:
+//   - []OracleMismatch: One entry per input on which the outputs differed (nil if none did)
+//   - error: FunctionNotProvidedError when either function is nil, SignatureMismatchError
+//     when their signatures differ, or an input generation error
+//
+// Example usage:
+//
+//	mismatches, err := NewOracleTest(sumFormula, sumIterative).WithIterations(100).Run()
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	for _, m := range mismatches {
+//	    t.Errorf("inputs %v: candidate returned %v, reference %v", m.Inputs, m.Candidate, m.Reference)
+//	}
+func (ot *OracleTest) RunWithAttributes(a attributes.AttributesStruct) (mismatches []OracleMismatch, err error) {
+	if ot.candidate == nil || ot.reference == nil {
+		return nil, FunctionNotProvidedError{}
+	}
+	if reflect.TypeOf(ot.candidate) != reflect.TypeOf(ot.reference) {
+		return nil, SignatureMismatchError{candidate: ot.candidate, reference: ot.reference}
+	}
+	if a == nil {
+		a = attributes.NewFTAttributes()
+	}
+	candidate, reference := NewPBTest(ot.candidate), NewPBTest(ot.reference)
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(ot.candidate).WithAttributes(a)
+	for i := uint(0); i < ot.iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		got, err := candidate.applyFunction(utils.DeepCopyAll(inputs)...)
+		if err != nil {
+			return nil, err
+		}
+		want, err := reference.applyFunction(utils.DeepCopyAll(inputs)...)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(got, want) {
+			mismatches = append(mismatches, OracleMismatch{Inputs: inputs, Candidate: got, Reference: want})
+		}
+	}
+	return mismatches, nil
+}
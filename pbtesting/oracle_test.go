@@ -0,0 +1,91 @@
+package pbtesting
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
+)
+
+func TestOracleTest(t *testing.T) {
+	sumIterative := func(n int) int {
+		sum := 0
+		for i := 0; i <= n; i++ {
+			sum += i
+		}
+		return sum
+	}
+	sumFormula := func(n int) int { return n * (n + 1) / 2 }
+	attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(0, 50))
+	mismatches, err := NewOracleTest(sumFormula, sumIterative).WithIterations(50).RunWithAttributes(attrs)
+	if err != nil || len(mismatches) != 0 {
+		t.Errorf("expected agreeing implementations, got %v (err %v)", mismatches, err)
+	}
+	offByOne := func(n int) int { return n * (n - 1) / 2 }
+	mismatches, err = NewOracleTest(offByOne, sumIterative).WithIterations(50).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mismatches) == 0 {
+		t.Fatal("expected mismatches for a wrong candidate")
+	}
+	for _, m := range mismatches {
+		n := m.Inputs[0].(int)
+		if m.Candidate != offByOne(n) || m.Reference != sumIterative(n) {
+			t.Errorf("unexpected mismatch record %+v", m)
+		}
+	}
+}
+
+func TestOracleTest_MultipleReturns(t *testing.T) {
+	divmod := func(a, b int) (int, int) { return a / b, a % b }
+	wrong := func(a, b int) (int, int) { return a / b, 0 }
+	attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(1, 100))
+	mismatches, err := NewOracleTest(wrong, divmod).WithIterations(50).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, m := range mismatches {
+		if m.Reference.([]any)[1] == 0 {
+			t.Errorf("expected mismatches only where the remainder is non-zero, got %+v", m)
+		}
+	}
+}
+
+func TestOracleTest_MutatingCandidate(t *testing.T) {
+	sortFirst := func(s []int) int {
+		slices.Sort(s)
+		return s[0]
+	}
+	first := func(s []int) int { return s[0] }
+	attrs := attributes.NewFTAttributes()
+	attrs.SliceAttr = attributes.SliceAttributes{MinLen: 3, MaxLen: 5, ElementAttrs: attributes.IntegerAttributesImpl[int]{Min: 0, Max: 1000}}
+	mismatches, err := NewOracleTest(sortFirst, first).WithIterations(50).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mismatches) == 0 {
+		t.Fatal("expected the reference to see the inputs before the candidate sorted them")
+	}
+	for _, m := range mismatches {
+		s := m.Inputs[0].([]int)
+		if m.Reference != s[0] || m.Candidate != slices.Min(s) {
+			t.Errorf("expected the recorded inputs to be the generated ones, got %+v", m)
+		}
+	}
+}
+
+func TestOracleTest_Errors(t *testing.T) {
+	if _, err := NewOracleTest(nil, strconv.Itoa).Run(); !errors.As(err, new(FunctionNotProvidedError)) {
+		t.Errorf("expected FunctionNotProvidedError, got %v", err)
+	}
+	_, err := NewOracleTest(strconv.Itoa, strconv.Quote).Run()
+	if !errors.As(err, new(SignatureMismatchError)) {
+		t.Errorf("expected SignatureMismatchError, got %v", err)
+	}
+	if err.Error() != "candidate and reference must have the same signature, got func(int) string and func(string) string" {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
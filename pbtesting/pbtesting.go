@@ -24,21 +24,11 @@
 //	        Verify: func(v any) bool { return v.(int) >= 0 },
 //	    }
 //
-//	    test := NewPBTest(math.Abs).
+//	    NewPBTest(math.Abs).
 //	        WithIterations(1000).
 //	        WithPredicates(nonNegative).
-//	        WithT(t)
-//
-//	    results, err := test.Run()
-//	    if err != nil {
-//	        t.Fatal(err)
-//	    }
-//
-//	    // Check for failures
-//	    failures := FilterPBTTestOut(results)
-//	    if len(failures) > 0 {
-//	        t.Errorf("Found %d failing cases", len(failures))
-//	    }
+//	        WithT(t).
+//	        Assert() // reports failures with t.Errorf
 //	}
 //
 // Advanced Usage with Custom Attributes:
@@ -68,8 +58,16 @@
 package pbtesting
 
 import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/laiambryant/gotestutils/ftesting"
 	"github.com/laiambryant/gotestutils/ftesting/attributes"
@@ -90,8 +88,34 @@ import (
 //   - t: The testing.T instance for reporting results
 //   - f: The function to test (can be any function signature)
 //   - predicates: List of predicates that outputs must satisfy
+//   - tuple: If true, multi-value outputs are verified as a whole []any rather than element by element
 //   - iterations: Number of test iterations to run
 //   - argAttrs: Custom attributes for controlling input generation
+//   - zeroFirst: If true, the first iteration uses the zero value of every parameter
+//   - determinism: If true, the function is called twice per input tuple and the outputs compared
+//   - immutability: If true, the function is called with deep copies of its arguments, which
+//     are compared with the generated ones afterwards
+//   - seedCorpus: Input tuples replayed and mutated alongside random inputs
+//   - fixedArgs: Arguments held at a constant value, by parameter index
+//   - argGroups: Attributes generating consecutive arguments together, by first parameter index
+//   - uniqueArgs: Redraw budgets of the arguments that must not repeat within a run, by index
+//   - interfaceImpls: Attributes of the implementations generating parameters of an interface type
+//   - maxZeroFraction: If positive, Run logs a warning for parameters that were the zero value
+//     in more than this fraction of iterations
+//   - assumptions: Predicates over the input tuple; inputs failing any of them are discarded
+//   - maxDiscardRatio: If positive, Run fails when more than this fraction of inputs is discarded
+//   - classifier: If set, labels the inputs of every iteration for the label histogram
+//   - labels: The label histogram of the last run, counting iterations per classifier label
+//   - maxResults: If positive, Run retains at most this many failing results and no passing ones
+//   - passed, failed: The number of passing and failing results of the last run, retained or not
+//   - shrinking: If true, the inputs of failing iterations are simplified before being reported
+//   - shrinkers: The shrinkers set with WithShrinker, by the exact type they shrink
+//   - timeout: If positive, Run starts no new iteration once this much time has passed
+//   - truncated: Whether the last run was stopped by the timeout before all iterations ran
+//   - seed: If seeded is set, the seed the shared random source is reset to before each run
+//   - methodName: For tests built with NewPBTestMethod, the name of the method bound as f
+//   - receiverAttrs: If set, attributes generating a fresh receiver for every iteration
+//   - err: An error found while building the test, returned by Run
 //
 // Example usage:
 //
@@ -100,37 +124,86 @@ import (
 //	    WithPredicates(nonNegative, lessThan100).
 //	    WithT(t)
 type PBTest struct {
-	t          *testing.T
-	f          any
-	predicates []p.Predicate
-	iterations uint
-	argAttrs   []any
+	t               *testing.T
+	f               any
+	predicates      []p.Predicate
+	tuple           bool
+	iterations      uint
+	argAttrs        []any
+	zeroFirst       bool
+	determinism     bool
+	immutability    bool
+	maxZeroFraction float64
+	seedCorpus      [][]any
+	fixedArgs       map[int]any
+	argGroups       map[int]attributes.Attributes
+	uniqueArgs      map[int]int
+	interfaceImpls  map[reflect.Type][]attributes.Attributes
+	assumptions     []p.Predicate
+	maxDiscardRatio float64
+	classifier      func(inputs []any) string
+	labels          map[string]int
+	maxResults      int
+	passed          uint
+	failed          uint
+	shrinking       bool
+	shrinkers       map[reflect.Type]Shrinker
+	timeout         time.Duration
+	truncated       bool
+	seed            int64
+	seeded          bool
+
+	methodName    string
+	receiverAttrs attributes.Attributes
+	err           error
 }
 
 // PBTestOut represents the result of a single property-based test iteration.
-// It contains the function output, any predicates that failed, and a success flag.
+// It contains the generated inputs, the function output, any predicates that failed,
+// and a success flag. Determinism failures also carry the output of the second call, and
+// immutability failures the indices of the mutated arguments.
 //
 // Fields:
+//   - Inputs: The generated arguments the function was called with
 //   - Output: The value returned by the function under test
 //   - Predicates: List of predicates that failed for this output (nil if all passed)
+//   - PassedPredicates: List of predicates that passed for this output, so that
+//     PredicateStats can count both outcomes
+//   - RerunOutput: The output of the repeated call when a determinism check failed (nil otherwise)
+//   - Mutated: The indices of the arguments the function modified when an immutability
+//     check failed (nil otherwise)
+//   - ShrunkFrom: With WithShrinking, the originally generated inputs that Inputs were shrunk
+//     from (nil otherwise)
+//   - Label: With WithClassifier, the label of the generated inputs ("" otherwise)
 //   - Ok: true if all predicates passed, false if any failed
 //
-// Use FilterPBTTestOut to extract only the failing test cases from a slice of results.
+// Use FilterPBTTestOut to extract only the failing test cases from a slice of results,
+// or Passed to check a single result.
 //
 // Example usage:
 //
 //	results, _ := test.Run()
 //	for _, result := range results {
-//	    if !result.Ok {
+//	    if !result.Passed() {
 //	        t.Errorf("Output %v failed predicates: %v", result.Output, result.Predicates)
 //	    }
 //	}
 type PBTestOut struct {
-	Output     any
-	Predicates []p.Predicate
-	Ok         bool
+	Inputs           []any
+	Output           any
+	Predicates       []p.Predicate
+	PassedPredicates []p.Predicate
+	RerunOutput      any
+	Mutated          []int
+	ShrunkFrom       []any
+	Label            string
+	Ok               bool
 }
 
+// Passed reports whether every check on this result succeeded, i.e. whether Ok is true.
+// It is the accessor FilterPBTTestOut uses to select failures.
+func (po PBTestOut) Passed() bool { return po.Ok }
+
 // returnTypes is an internal type constraint for function return values.
 // It allows functions to return either a single value or multiple values (as a slice).
 type returnTypes interface {
@@ -153,6 +226,71 @@ type returnTypes interface {
 //	test.WithIterations(100).WithPredicates(nonNegative)
 func NewPBTest(f any) *PBTest { return &PBTest{f: f, iterations: 1} }
 
+// NewPBTestMethod creates a property-based test for the method methodName of receiver.
+// The method is bound with reflection, so arguments are generated for its parameters only
+// and its return values are validated exactly like those of a plain function, without a
+// wrapping closure. Use WithReceiverAttributes to call the method on a freshly generated
+// receiver in every iteration instead of sharing receiver across iterations.
+//
+// Parameters:
+//   - receiver: The value whose method is tested; pointer receivers need a pointer
+//   - methodName: The name of an exported method of receiver
+//
+// Returns a PBTest instance configured with 1 iteration by default. When receiver has
+// no such method, Run returns a MethodNotFoundError.
+//
+// Example usage:
+//
+//	stack := &Stack{}
+//	test := NewPBTestMethod(stack, "Push").WithIterations(100).WithPredicates(pred)
+func NewPBTestMethod(receiver any, methodName string) *PBTest {
+	pbt := &PBTest{iterations: 1, methodName: methodName}
+	pbt.f, pbt.err = bindMethod(receiver, methodName)
+	return pbt
+}
+
+// WithReceiverAttributes makes a test built with NewPBTestMethod generate a fresh
+// receiver with attrs before every iteration and call the method on it, so that state
+// left behind by one iteration does not leak into the next.
+//
+// Parameters:
+//   - attrs: Attributes generating receivers of a type that has the method
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	fresh := attributes.PointerAttributes{Depth: 1, Inner: attributes.StructAttributes{TargetType: reflect.TypeOf(Stack{})}}
+//	NewPBTestMethod(nil, "Push").WithReceiverAttributes(fresh).WithIterations(100)
+func (pbt *PBTest) WithReceiverAttributes(attrs attributes.Attributes) *PBTest {
+	pbt.receiverAttrs = attrs
+	return pbt
+}
+
+// bindMethod returns the method methodName of receiver bound to it as a function value.
+func bindMethod(receiver any, methodName string) (any, error) {
+	v := reflect.ValueOf(receiver)
+	if !v.IsValid() {
+		return nil, MethodNotFoundError{receiver: receiver, method: methodName}
+	}
+	m := v.MethodByName(methodName)
+	if !m.IsValid() {
+		return nil, MethodNotFoundError{receiver: receiver, method: methodName}
+	}
+	return m.Interface(), nil
+}
+
+// bindFreshReceiver generates a new receiver with the receiver attributes and binds the
+// tested method to it.
+func (pbt *PBTest) bindFreshReceiver() error {
+	receiver, err := attributes.GenerateValue(pbt.receiverAttrs)
+	if err != nil {
+		return err
+	}
+	pbt.f, pbt.err = bindMethod(receiver, pbt.methodName)
+	return pbt.err
+}
+
 // WithIterations sets the number of test iterations to run.
 // Each iteration generates new random inputs and validates the output.
 //
@@ -180,7 +318,38 @@ func (pbt *PBTest) WithIterations(n uint) *PBTest { pbt.iterations = n; return p
 //	    predicates.NewNonNegative(),
 //	    predicates.NewLessThan(100),
 //	)
-func (pbt *PBTest) WithPredicates(preds ...p.Predicate) *PBTest { pbt.predicates = preds; return pbt }
+func (pbt *PBTest) WithPredicates(preds ...p.Predicate) *PBTest {
+	pbt.predicates = preds
+	pbt.tuple = false
+	return pbt
+}
+
+// WithTuplePredicates sets predicates that receive the whole result tuple of a
+// multi-return function as a single []any, instead of each return value separately.
+// This makes relational properties between return values expressible. Functions
+// returning a single value are validated exactly as with WithPredicates.
+//
+// Parameters:
+//   - preds: One or more predicates to validate the []any tuple against
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	type divModIdentity struct{}
+//
+//	func (divModIdentity) Verify(v any) bool {
+//	    r := v.([]any) // dividend, divisor, quotient, remainder
+//	    return r[2].(int)*r[1].(int)+r[3].(int) == r[0].(int)
+//	}
+//
+//	divMod := func(a, b int) (int, int, int, int) { return a, b, a / b, a % b }
+//	test.WithF(divMod).WithTuplePredicates(divModIdentity{})
+func (pbt *PBTest) WithTuplePredicates(preds ...p.Predicate) *PBTest {
+	pbt.predicates = preds
+	pbt.tuple = true
+	return pbt
+}
 
 // WithArgAttributes sets custom attributes for controlling how random input values
 // are generated. This allows fine-grained control over the input space explored
@@ -197,6 +366,382 @@ func (pbt *PBTest) WithPredicates(preds ...p.Predicate) *PBTest { pbt.predicates
 //	test.WithArgAttributes(intAttr)
 func (pbt *PBTest) WithArgAttributes(attrs ...any) *PBTest { pbt.argAttrs = attrs; return pbt }
 
+// WithZeroFirst makes the first iteration call the function with the zero value of
+// every parameter (including typed nil pointers, slices and maps) before the remaining
+// iterations proceed with random inputs. This guarantees that the degenerate all-zero
+// case is always tested and gives a reproducible first data point.
+//
+// Parameters:
+//   - zeroFirst: true to start with zero-valued inputs
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithZeroFirst(true).WithIterations(100)
+func (pbt *PBTest) WithZeroFirst(zeroFirst bool) *PBTest { pbt.zeroFirst = zeroFirst; return pbt }
+
+// WithDeterminismCheck makes every iteration call the function a second time with the
+// same inputs and compare both outputs with reflect.DeepEqual. When they differ, a
+// failing PBTestOut holding both outputs (Output and RerunOutput) is recorded, which
+// catches hidden dependencies on map iteration order, time or randomness. The check
+// runs whether or not predicates are configured.
+//
+// Functions that mutate their arguments see the mutated values on the second call,
+// unless WithImmutabilityCheck is enabled as well.
+//
+// Parameters:
+//   - determinism: true to re-run the function and compare outputs
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithDeterminismCheck(true).WithIterations(100)
+func (pbt *PBTest) WithDeterminismCheck(determinism bool) *PBTest {
+	pbt.determinism = determinism
+	return pbt
+}
+
+// WithImmutabilityCheck makes every iteration call the function with deep copies of the
+// generated arguments and compare them with the originals afterwards. When the function
+// modified any argument, such as by sorting a slice in place or deleting map entries, a
+// failing PBTestOut listing the indices of the modified arguments in Mutated is recorded.
+// The check runs whether or not predicates are configured.
+//
+// Slices, maps, pointers, arrays, interfaces and the exported fields of structs are
+// copied recursively; unexported struct fields are copied shallowly, so that changes made
+// through them go unnoticed. NaN values compare equal to themselves.
+//
+// Parameters:
+//   - check: true to detect functions that modify their arguments
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test := NewPBTest(func(s []int) int { slices.Sort(s); return s[len(s)/2] }).
+//	    WithImmutabilityCheck(true)
+func (pbt *PBTest) WithImmutabilityCheck(check bool) *PBTest {
+	pbt.immutability = check
+	return pbt
+}
+
+// WithSeedCorpus makes the test replay the given known-interesting input tuples before
+// random ones and keep mixing light mutations of them into later iterations. See
+// ftesting.FTesting.WithSeedCorpus for the replay and validation rules.
+//
+// Parameters:
+//   - seeds: The seed input tuples
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithSeedCorpus([]any{0, 0}, []any{math.MaxInt, 1}).WithIterations(100)
+func (pbt *PBTest) WithSeedCorpus(seeds ...[]any) *PBTest { pbt.seedCorpus = seeds; return pbt }
+
+// WithSeed makes every run reset the random source shared by the generators to seed
+// before generating inputs (see attributes.Seed), so that a run, and a failure reported
+// by Assert, can be reproduced. Reproducibility requires that no other test generates
+// values concurrently.
+//
+// Parameters:
+//   - seed: The seed to reset the random source to
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithSeed(1712345678) // the seed printed by a failing Assert
+func (pbt *PBTest) WithSeed(seed int64) *PBTest {
+	pbt.seed = seed
+	pbt.seeded = true
+	return pbt
+}
+
+// WithFixedArg holds the argument at index to value while the other arguments keep being
+// generated. See ftesting.FTesting.WithFixedArg for the conversion and validation rules.
+//
+// Parameters:
+//   - index: The position of the parameter to hold fixed
+//   - value: The value passed for that parameter
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithFixedArg(1, 3).WithIterations(100) // vary only the first argument
+func (pbt *PBTest) WithFixedArg(index int, value any) *PBTest {
+	if pbt.fixedArgs == nil {
+		pbt.fixedArgs = map[int]any{}
+	}
+	pbt.fixedArgs[index] = value
+	return pbt
+}
+
+// WithArgGroup generates the arguments starting at index together from attrs, such as the
+// start and end of an interval generated by attributes.IntervalAttributes. See
+// ftesting.FTesting.WithArgGroup for how the generated values are spread over the
+// parameters. Shrinking leaves grouped arguments as generated.
+//
+// Parameters:
+//   - index: The position of the first parameter of the group
+//   - attrs: The attributes generating the values of the whole group
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	bounds := attributes.IntegerAttributesImpl[int]{Min: 0, Max: 100}
+//	NewPBTest(overlaps).WithArgGroup(0, attributes.IntervalAttributes{Bounds: bounds, Pair: attributes.IntervalOverlapping})
+func (pbt *PBTest) WithArgGroup(index int, attrs attributes.Attributes) *PBTest {
+	if pbt.argGroups == nil {
+		pbt.argGroups = map[int]attributes.Attributes{}
+	}
+	pbt.argGroups[index] = attrs
+	return pbt
+}
+
+// WithUniqueArg makes the argument at index distinct across all iterations of a run,
+// redrawing values that were already generated, for example so that every iteration
+// inserts a fresh ID into a shared store. Values are tracked per Run. See
+// ftesting.FTesting.WithUniqueArg for how repeats are detected and the errors Run returns
+// when the parameter cannot be made unique or maxRetries redraws all repeat.
+//
+// Parameters:
+//   - index: The position of the parameter whose values must not repeat
+//   - maxRetries: The number of redraws allowed per iteration; 0 uses 100
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	NewPBTest(store.Insert).WithUniqueArg(0, 0).WithIterations(1000)
+func (pbt *PBTest) WithUniqueArg(index int, maxRetries int) *PBTest {
+	if pbt.uniqueArgs == nil {
+		pbt.uniqueArgs = map[int]int{}
+	}
+	pbt.uniqueArgs[index] = maxRetries
+	return pbt
+}
+
+// WithInterfaceImplementations makes parameters of the interface type ifaceType receive
+// values generated by impls, one implementation chosen at random per value, so that a
+// function such as func(w io.Writer) error can be tested against several writers. Run
+// verifies that every implementation generates a type implementing ifaceType and fails
+// with an UnsupportedParameterError otherwise. See attributes.ImplementationsAttributes.
+//
+// The implementations are registered on the attributes of the run with
+// FTAttributes.RegisterType, overriding a registration of ifaceType made there; custom
+// AttributesStruct implementations passed to RunWithAttributes are left unchanged. Calling
+// WithInterfaceImplementations again for the same type replaces its implementations.
+//
+// Parameters:
+//   - ifaceType: The interface type of the parameters to generate
+//   - impls: The attributes generating the implementations
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	buffers := attributes.PointerAttributes{Depth: 1, Inner: attributes.StructAttributes{TargetType: reflect.TypeOf(bytes.Buffer{})}}
+//	NewPBTest(writeReport).WithInterfaceImplementations(reflect.TypeFor[io.Writer](), buffers, countingWriters)
+func (pbt *PBTest) WithInterfaceImplementations(ifaceType reflect.Type, impls ...attributes.Attributes) *PBTest {
+	if pbt.interfaceImpls == nil {
+		pbt.interfaceImpls = map[reflect.Type][]attributes.Attributes{}
+	}
+	pbt.interfaceImpls[ifaceType] = impls
+	return pbt
+}
+
+// withInterfaceImplementations registers the implementations set with
+// WithInterfaceImplementations on a. Only an FTAttributes can be adjusted; other
+// attributes are returned unchanged.
+func (pbt *PBTest) withInterfaceImplementations(a attributes.AttributesStruct) attributes.AttributesStruct {
+	ftAttrs, ok := a.(attributes.FTAttributes)
+	if !ok || len(pbt.interfaceImpls) == 0 {
+		return a
+	}
+	for iface, impls := range pbt.interfaceImpls {
+		ftAttrs.RegisterType(iface, attributes.ImplementationsAttributes{Interface: iface, Impls: impls})
+	}
+	return ftAttrs
+}
+
+// groupedArgs returns the parameter positions generated by argument groups: every
+// element of a group generating arrays, or the first position of other groups.
+func (pbt *PBTest) groupedArgs() map[int]bool {
+	grouped := map[int]bool{}
+	for index, attrs := range pbt.argGroups {
+		grouped[index] = true
+		if t := attrs.GetReflectType(); t != nil && t.Kind() == reflect.Array {
+			for j := range t.Len() {
+				grouped[index+j] = true
+			}
+		}
+	}
+	return grouped
+}
+
+// WithZeroInputWarning makes Run log a warning through the configured testing.T for every
+// parameter that was the zero value in more than maxFraction of the iterations. Many
+// generators fall back to the zero value when misconfigured (an invalid range, missing
+// element attributes), so a run can silently test nothing but the zero input; the
+// warning turns that into a visible signal. The zero-valued first iteration requested
+// by WithZeroFirst is not counted.
+//
+// Parameters:
+//   - maxFraction: The tolerated fraction of zero values, between 0 and 1
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithT(t).WithZeroInputWarning(0.5) // warn when over half the inputs were zero
+func (pbt *PBTest) WithZeroInputWarning(maxFraction float64) *PBTest {
+	pbt.maxZeroFraction = maxFraction
+	return pbt
+}
+
+// WithAssumption sets preconditions on the generated inputs. Each predicate receives
+// the whole input tuple as a []any, like a tuple predicate receives the outputs; inputs
+// failing any of them are discarded without calling the function, and the iteration
+// produces no result. Discarded inputs still count towards the iterations, so combine
+// assumptions with WithMaxDiscardRatio to catch a precondition that rejects almost
+// everything and leaves the property vacuously true.
+//
+// Parameters:
+//   - preds: Predicates the input tuple must satisfy
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	type nonZeroDivisor struct{}
+//
+//	func (nonZeroDivisor) Verify(v any) bool { return v.([]any)[1].(int) != 0 }
+//
+//	test.WithF(divide).WithAssumption(nonZeroDivisor{}).WithMaxDiscardRatio(0.5)
+func (pbt *PBTest) WithAssumption(preds ...p.Predicate) *PBTest {
+	pbt.assumptions = preds
+	return pbt
+}
+
+// WithMaxDiscardRatio makes Run return an AssumptionTooStrictError when the fraction of
+// inputs discarded by WithAssumption exceeds maxRatio. A ratio of zero, the default,
+// disables the check.
+//
+// Parameters:
+//   - maxRatio: The tolerated fraction of discarded inputs, between 0 and 1
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithAssumption(sortedInput{}).WithMaxDiscardRatio(0.9)
+func (pbt *PBTest) WithMaxDiscardRatio(maxRatio float64) *PBTest {
+	pbt.maxDiscardRatio = maxRatio
+	return pbt
+}
+
+// WithClassifier labels every iteration of Run with a category of its inputs, such as
+// "empty", "sorted" or "negative", to show whether the generated inputs cover the cases
+// that matter. Each result carries the label of its inputs in Label, Labels returns the
+// number of iterations per label after the run, and when a testing.T is set Run logs the
+// label histogram through t.Logf. Inputs are classified as generated, before the function
+// under test is called. Inputs discarded by WithAssumption are not classified, and shrunk
+// inputs keep the label of the inputs they were shrunk from.
+//
+// Parameters:
+//   - classifier: A function returning the label of the input tuple of one iteration
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithClassifier(func(inputs []any) string {
+//	    if len(inputs[0].([]int)) == 0 {
+//	        return "empty"
+//	    }
+//	    return "non-empty"
+//	})
+//	// Run logs e.g. "labels: non-empty 97.0% (97), empty 3.0% (3)"
+func (pbt *PBTest) WithClassifier(classifier func(inputs []any) string) *PBTest {
+	pbt.classifier = classifier
+	return pbt
+}
+
+// Labels returns the label histogram of the last Run: the number of iterations whose
+// inputs the classifier set with WithClassifier gave each label. It is nil when no
+// classifier is set.
+func (pbt *PBTest) Labels() map[string]int { return pbt.labels }
+
+// WithMaxResults bounds the memory held by the results of Run, which otherwise keeps one
+// PBTestOut per validated output: a run of ten million iterations would hold ten million
+// results even when they almost all pass. With a positive n, Run returns only failing
+// results, at most the first n of them, and ResultCounts still counts every result. Helpers
+// working on the returned slice, such as PredicateStats, then only see the retained
+// failures. The default, 0, retains every result.
+//
+// Parameters:
+//   - n: The maximum number of failing results to retain; 0 retains every result
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	failures, _ := test.WithIterations(10_000_000).WithMaxResults(100).Run()
+//	passed, failed := test.ResultCounts()
+func (pbt *PBTest) WithMaxResults(n int) *PBTest { pbt.maxResults = n; return pbt }
+
+// ResultCounts returns the number of passing and failing results of the last Run,
+// including those that WithMaxResults did not retain.
+func (pbt *PBTest) ResultCounts() (passed, failed uint) { return pbt.passed, pbt.failed }
+
+// WithShrinking makes Run simplify the inputs of every failing iteration before reporting
+// it: arguments are repeatedly replaced by simpler candidates (smaller integers, shorter
+// strings, slices and maps) as long as the property keeps failing and the assumptions
+// set with WithAssumption hold. The failing PBTestOut then holds the shrunk inputs and
+// their outputs, with the generated inputs in ShrunkFrom. Candidates come from the
+// Shrinker set for the argument type with WithShrinker, or from the built-in shrinker for
+// its kind.
+//
+// Parameters:
+//   - shrinking: true to shrink failing inputs
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	results, _ := test.WithShrinking(true).Run()
+//	// a failure on []int{93, -17, 4} may be reported as Inputs []any{[]int{1}}
+func (pbt *PBTest) WithShrinking(shrinking bool) *PBTest { pbt.shrinking = shrinking; return pbt }
+
+// WithTimeout caps the total time of Run: once d has elapsed no new iteration is started,
+// and Run returns the results gathered so far with Truncated reporting true. An iteration
+// already running is not interrupted, except through its context: when the first
+// parameter of the function under test is a context.Context, every call receives a
+// context that is cancelled when the deadline passes (or when Run returns). A duration of
+// zero, the default, disables the timeout.
+//
+// Parameters:
+//   - d: The longest time Run may keep starting iterations
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	results, _ := test.WithIterations(1_000_000).WithTimeout(30 * time.Second).Run()
+//	if test.Truncated() {
+//	    t.Logf("ran %d iterations before the timeout", len(results))
+//	}
+func (pbt *PBTest) WithTimeout(d time.Duration) *PBTest { pbt.timeout = d; return pbt }
+
+// Truncated reports whether the last Run stopped before performing every iteration
+// because the timeout set with WithTimeout passed.
+func (pbt *PBTest) Truncated() bool { return pbt.truncated }
+
 // WithT sets the testing.T instance for integration with Go's testing framework.
 // While not required for test execution, it's recommended for proper test reporting.
 //
@@ -243,6 +788,11 @@ func (pbt *PBTest) WithF(f any) *PBTest { pbt.f = f; return pbt }
 //
 // If no predicates are configured, all iterations are marked as successful (Ok=true).
 // If the function is nil, returns an empty slice with no error.
+// Functions without parameters are called once per iteration with empty Inputs, which
+// allows checking the outputs of nondeterministic generators. Functions whose first
+// parameter is a context.Context receive a context cancelled when Run returns, or when
+// the timeout set with WithTimeout passes. When the ContextAttr of the attributes is
+// configured, contexts are generated from it instead, derived from that same context.
 //
 // Example usage:
 //
@@ -337,35 +887,247 @@ func (pbt *PBTest) Run() (retOut []PBTestOut, err error) {
 //
 // See also: Run(), WithArgAttributes(), ftesting.WithAttributes()
 func (pbt *PBTest) RunWithAttributes(a attributes.AttributesStruct) (retOut []PBTestOut, err error) {
-	var fuzzTest *ftesting.FTesting
+	if pbt.receiverAttrs != nil {
+		if err := pbt.bindFreshReceiver(); err != nil {
+			return nil, err
+		}
+	}
+	if pbt.err != nil {
+		return nil, pbt.err
+	}
 	if pbt.f == nil {
 		return []PBTestOut{}, nil
 	}
+	if a == nil {
+		a = attributes.NewFTAttributes()
+	}
+	a = pbt.withInterfaceImplementations(a)
+	if err := pbt.validate(a); err != nil {
+		return nil, err
+	}
+	if pbt.seeded {
+		attributes.Seed(pbt.seed)
+	}
+	ctx, cancel := pbt.runContext()
+	defer cancel()
+	a, generatesContexts := withRunContext(a, ctx)
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(pbt.f).WithAttributes(a).WithZeroFirst(pbt.zeroFirst).WithSeedCorpus(pbt.seedCorpus...)
+	if _, fixed := pbt.fixedArgs[0]; !fixed && !generatesContexts && takesContext(pbt.f) {
+		fuzzTest.WithFixedArg(0, ctx)
+	}
+	for index, value := range pbt.fixedArgs {
+		fuzzTest.WithFixedArg(index, value)
+	}
+	for index, attrs := range pbt.argGroups {
+		fuzzTest.WithArgGroup(index, attrs)
+	}
+	for index, maxRetries := range pbt.uniqueArgs {
+		fuzzTest.WithUniqueArg(index, maxRetries)
+	}
+	var zeroCounts zeroCounter
+	var discarded, ran uint
+	pbt.truncated = false
+	pbt.passed, pbt.failed = 0, 0
+	pbt.labels = nil
+	if pbt.classifier != nil {
+		pbt.labels = map[string]int{}
+	}
 	for i := uint(0); i < pbt.iterations; i++ {
-		if a == nil {
-			fuzzTest = (&ftesting.FTesting{}).WithFunction(pbt.f).WithAttributes(attributes.NewFTAttributes())
-		} else {
-			fuzzTest = (&ftesting.FTesting{}).WithFunction(pbt.f).WithAttributes(a)
+		if pbt.timeout > 0 && ctx.Err() != nil {
+			pbt.truncated = true
+			break
+		}
+		ran++
+		if pbt.receiverAttrs != nil && i > 0 {
+			if err := pbt.bindFreshReceiver(); err != nil {
+				return nil, err
+			}
 		}
 		inputs, err := fuzzTest.GenerateInputs()
 		if err != nil {
 			return nil, err
 		}
-		outs, _ := pbt.applyFunction(inputs...)
-		if pbt.haspredicates() {
-			switch ret := outs.(type) {
-			case []any:
-				for _, out := range ret {
-					retOut = pbt.validatePredicates(retOut, out)
-				}
-			case any:
-				retOut = pbt.validatePredicates(retOut, ret)
+		if pbt.maxZeroFraction > 0 && !(pbt.zeroFirst && i == 0) {
+			zeroCounts.add(inputs)
+		}
+		if !pbt.assumed(inputs) {
+			discarded++
+			continue
+		}
+		var label string
+		if pbt.classifier != nil {
+			label = pbt.classifier(inputs)
+			pbt.labels[label]++
+		}
+		results := pbt.evaluate(inputs)
+		if pbt.shrinking && anyFailed(results) {
+			results = pbt.evaluate(pbt.shrink(inputs))
+			for j := range results {
+				results[j].ShrunkFrom = inputs
+			}
+		}
+		if pbt.classifier != nil {
+			for j := range results {
+				results[j].Label = label
 			}
 		}
+		for _, result := range results {
+			if result.Ok {
+				pbt.passed++
+			} else {
+				pbt.failed++
+			}
+			if pbt.maxResults <= 0 || (!result.Ok && len(retOut) < pbt.maxResults) {
+				retOut = append(retOut, result)
+			}
+		}
+	}
+	if pbt.maxDiscardRatio > 0 && float64(discarded) > pbt.maxDiscardRatio*float64(ran) {
+		return nil, AssumptionTooStrictError{Discarded: discarded, Total: ran}
+	}
+	if pbt.t != nil {
+		for _, zeroErr := range zeroCounts.errors(pbt.maxZeroFraction) {
+			pbt.t.Logf("warning: %v", zeroErr)
+		}
+		if n := fuzzTest.TruncatedInputs(); n > 0 {
+			pbt.t.Logf("warning: %d generated values were truncated by MaxGeneratedElements", n)
+		}
+		if len(pbt.labels) > 0 {
+			pbt.t.Logf("labels: %s", formatLabels(pbt.labels))
+		}
 	}
 	return retOut, nil
 }
 
+// Validate checks once, before any input is generated, that the default attributes can
+// produce a value for every parameter of the function under test, and returns an
+// UnsupportedParameterError naming the first parameter they cannot, such as a time.Time
+// parameter for which no attribute generates values. Run and RunWithAttributes perform
+// the same check with the attributes they use, so that a mismatch fails fast instead of
+// surfacing as a per-iteration call error.
+//
+// Parameters held with WithFixedArg and a leading context.Context parameter are not
+// generated and therefore not checked, and parameters covered by WithArgGroup are checked
+// when their group is generated. Validate returns nil when no function is set or
+// the function cannot be inspected, leaving those cases to Run.
+//
+// Example usage:
+//
+//	test := NewPBTest(func(at time.Time) bool { return at.IsZero() })
+//	err := test.Validate() // param 0 expects time.Time but the attributes generate struct {...}
+func (pbt *PBTest) Validate() error {
+	return pbt.validate(pbt.withInterfaceImplementations(attributes.NewFTAttributes()))
+}
+
+// validate checks that a can generate a value assignable or convertible to every
+// generated parameter of the function under test.
+func (pbt *PBTest) validate(a attributes.AttributesStruct) error {
+	fType := reflect.TypeOf(pbt.f)
+	if fType == nil || fType.Kind() != reflect.Func {
+		return nil
+	}
+	grouped := pbt.groupedArgs()
+	for i := 0; i < fType.NumIn(); i++ {
+		want := paramType(fType, i)
+		if _, fixed := pbt.fixedArgs[i]; fixed || grouped[i] || want == reflect.TypeFor[any]() || (i == 0 && takesContext(pbt.f)) {
+			continue
+		}
+		attrs, err := a.GetAttributeGivenType(want)
+		if err != nil {
+			var unsupported attributes.UnsupportedAttributeTypeError
+			if errors.As(err, &unsupported) {
+				return UnsupportedParameterError{Index: i, Type: want, Reason: "no attribute is registered for it", Err: err}
+			}
+			return UnsupportedParameterError{Index: i, Type: want, Reason: err.Error(), Err: err}
+		}
+		got := attrs.GetReflectType()
+		if got != nil && !got.AssignableTo(want) && !got.ConvertibleTo(want) {
+			return UnsupportedParameterError{Index: i, Type: want, Reason: fmt.Sprintf("the attributes generate %v", got)}
+		}
+	}
+	return nil
+}
+
+// runContext returns the context of one run: it expires after the timeout set with
+// WithTimeout, if any, and is cancelled by the returned function.
+func (pbt *PBTest) runContext() (context.Context, context.CancelFunc) {
+	if pbt.timeout > 0 {
+		return context.WithTimeout(context.Background(), pbt.timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// withRunContext makes the contexts generated by the ContextAttr of a derive from ctx, the
+// context of the run, unless they have a parent already. It reports false, leaving a
+// unchanged, when a is not an FTAttributes with a configured ContextAttr.
+func withRunContext(a attributes.AttributesStruct, ctx context.Context) (attributes.AttributesStruct, bool) {
+	ftAttrs, ok := a.(attributes.FTAttributes)
+	if !ok || !ftAttrs.ContextAttr.Configured() {
+		return a, false
+	}
+	if ftAttrs.ContextAttr.Parent == nil {
+		ftAttrs.ContextAttr.Parent = ctx
+	}
+	return ftAttrs, true
+}
+
+// takesContext reports whether f is a function whose first parameter is a context.Context.
+func takesContext(f any) bool {
+	fType := reflect.TypeOf(f)
+	return fType != nil && fType.Kind() == reflect.Func && fType.NumIn() > 0 && fType.In(0) == reflect.TypeFor[context.Context]()
+}
+
+// assumed reports whether inputs satisfy every predicate set with WithAssumption.
+func (pbt *PBTest) assumed(inputs []any) bool {
+	for _, pred := range pbt.assumptions {
+		if !pred.Verify(inputs) {
+			return false
+		}
+	}
+	return true
+}
+
+// evaluate applies the function to one tuple of generated inputs and validates the
+// outputs against the configured predicates, recording the inputs on every result.
+//
+// Parameters:
+//   - inputs: The generated arguments for this iteration
+//
+// Returns one PBTestOut per validated output plus one for each failed immutability and
+// determinism check, or nil when no predicates are configured and no check failed.
+//
+// This method is called internally by Run for each iteration.
+func (pbt *PBTest) evaluate(inputs []any) (results []PBTestOut) {
+	outs, mutated := pbt.applyChecked(inputs)
+	if mutated != nil {
+		results = append(results, PBTestOut{Inputs: inputs, Output: outs, Mutated: mutated, Ok: false})
+	}
+	if pbt.determinism {
+		if rerun, _ := pbt.applyChecked(inputs); !reflect.DeepEqual(outs, rerun) {
+			results = append(results, PBTestOut{Inputs: inputs, Output: outs, RerunOutput: rerun, Ok: false})
+		}
+	}
+	if !pbt.haspredicates() {
+		return results
+	}
+	switch ret := outs.(type) {
+	case []any:
+		if pbt.tuple {
+			results = pbt.validatePredicates(results, ret)
+			break
+		}
+		for _, out := range ret {
+			results = pbt.validatePredicates(results, out)
+		}
+	case any:
+		results = pbt.validatePredicates(results, ret)
+	}
+	for i := range results {
+		results[i].Inputs = inputs
+	}
+	return results
+}
+
 // validatePredicates checks if an output value satisfies all configured predicates
 // and appends the result to the output slice.
 //
@@ -377,20 +1139,13 @@ func (pbt *PBTest) RunWithAttributes(a attributes.AttributesStruct) (retOut []PB
 //
 // This method is called internally by Run for each function output.
 func (pbt PBTest) validatePredicates(retOut []PBTestOut, out any) []PBTestOut {
-	if Ok, failedpredicates := pbt.satisfyAll(out); !Ok {
-		retOut = append(retOut, PBTestOut{
-			Output:     out,
-			Predicates: failedpredicates,
-			Ok:         false,
-		})
-	} else {
-		retOut = append(retOut, PBTestOut{
-			Output:     out,
-			Predicates: nil,
-			Ok:         true,
-		})
-	}
-	return retOut
+	passed, failed := pbt.verifyEach(out)
+	return append(retOut, PBTestOut{
+		Output:           out,
+		Predicates:       failed,
+		PassedPredicates: passed,
+		Ok:               failed == nil,
+	})
 }
 
 // applyFunction executes the test function with the given arguments and returns the result(s).
@@ -426,10 +1181,16 @@ func (pbt *PBTest) applyFunction(args ...any) (returnTypes, error) {
 	if fType.Kind() != reflect.Func {
 		return nil, &InvalidFunctionProvidedError{pbt.f}
 	}
+	if (!fType.IsVariadic() && len(args) != fType.NumIn()) || (fType.IsVariadic() && len(args) < fType.NumIn()-1) {
+		return nil, &InvalidFunctionProvidedError{pbt.f}
+	}
 	reflectArgs := make([]reflect.Value, len(args))
 	for i, arg := range args {
 		argValue := reflect.ValueOf(arg)
-		expectedType := fType.In(i)
+		expectedType := paramType(fType, i)
+		if !argValue.IsValid() {
+			argValue = reflect.Zero(expectedType)
+		}
 		if argValue.Type() != expectedType {
 			if argValue.Type().ConvertibleTo(expectedType) {
 				argValue = argValue.Convert(expectedType)
@@ -453,6 +1214,15 @@ func (pbt *PBTest) applyFunction(args ...any) (returnTypes, error) {
 	}
 }
 
+// paramType returns the type expected for the i-th argument of fType. Arguments at or
+// past the variadic parameter of a variadic function take its element type.
+func paramType(fType reflect.Type, i int) reflect.Type {
+	if fType.IsVariadic() && i >= fType.NumIn()-1 {
+		return fType.In(fType.NumIn() - 1).Elem()
+	}
+	return fType.In(i)
+}
+
 // satisfyAll checks if a value satisfies all configured predicates.
 //
 // Parameters:
@@ -464,20 +1234,23 @@ func (pbt *PBTest) applyFunction(args ...any) (returnTypes, error) {
 //
 // If no predicates are configured, returns (true, nil).
 //
-// This method is called internally by validatePredicates.
+// It is the shorthand of verifyEach for callers that only need the failures.
 func (pbt *PBTest) satisfyAll(val any) (Ok bool, failedpredicates []p.Predicate) {
-	if len(pbt.predicates) == 0 {
-		return true, nil
-	}
+	_, failedpredicates = pbt.verifyEach(val)
+	return failedpredicates == nil, failedpredicates
+}
+
+// verifyEach checks a value against every configured predicate, splitting them into
+// those that passed and those that failed. Either slice is nil when empty.
+func (pbt *PBTest) verifyEach(val any) (passed, failed []p.Predicate) {
 	for _, predicate := range pbt.predicates {
-		if !predicate.Verify(val) {
-			failedpredicates = append(failedpredicates, predicate)
+		if predicate.Verify(val) {
+			passed = append(passed, predicate)
+		} else {
+			failed = append(failed, predicate)
 		}
 	}
-	if len(failedpredicates) > 0 {
-		return false, failedpredicates
-	}
-	return true, nil
+	return passed, failed
 }
 
 // haspredicates checks if any predicates are configured for this test.
@@ -495,8 +1268,8 @@ func (pbt *PBTest) haspredicates() bool {
 // Parameters:
 //   - in: A slice of PBTestOut results from Run()
 //
-// Returns a new slice containing only the results where Ok is false (i.e., where
-// at least one predicate failed).
+// Returns a new slice containing only the results for which Passed is false (i.e.,
+// where at least one predicate or check failed).
 //
 // Example usage:
 //
@@ -512,6 +1285,181 @@ func (pbt *PBTest) haspredicates() bool {
 //	}
 func FilterPBTTestOut(in []PBTestOut) []PBTestOut {
 	return utils.Filter(in, func(po PBTestOut) bool {
-		return !po.Ok
+		return !po.Passed()
 	})
 }
+
+// PredicateStat counts how often a predicate passed and failed across a run.
+type PredicateStat struct {
+	Pass int
+	Fail int
+}
+
+// PredicateStats reports, per predicate, how often it passed and failed across the
+// results of a run. A predicate that almost never fails may not be reached by the
+// generated inputs, which suggests widening their attributes.
+//
+// Parameters:
+//   - results: The results of a Run, which record the passed and failed predicates of
+//     every validated output
+//
+// Returns a map keyed by predicate name: the String method when the predicate has one,
+// its printed value otherwise (as in exported reports). Predicates with the same name
+// share one entry. Determinism failures carry no predicate results and are not counted.
+//
+// Example usage:
+//
+//	results, _ := NewPBTest(abs).WithIterations(1000).WithPredicates(nonNegative, small).Run()
+//	for name, stat := range PredicateStats(results) {
+//	    t.Logf("%s: %d passed, %d failed", name, stat.Pass, stat.Fail)
+//	}
+func PredicateStats(results []PBTestOut) map[string]PredicateStat {
+	stats := map[string]PredicateStat{}
+	for _, result := range results {
+		for _, pred := range result.PassedPredicates {
+			name := fmt.Sprint(pred)
+			stat := stats[name]
+			stat.Pass++
+			stats[name] = stat
+		}
+		for _, pred := range result.Predicates {
+			name := fmt.Sprint(pred)
+			stat := stats[name]
+			stat.Fail++
+			stats[name] = stat
+		}
+	}
+	return stats
+}
+
+// RequireDistinctInputs checks that a run explored enough of the input space for one
+// parameter, guarding against attributes so narrow that a property becomes vacuous
+// (for example a bool parameter that was only ever false).
+//
+// Parameters:
+//   - results: The results of a Run, which record the inputs of every iteration
+//   - paramIndex: The index of the parameter to inspect
+//   - minDistinct: The minimum number of distinct values the parameter must have taken
+//
+// Returns an InsufficientInputDiversityError when fewer than minDistinct distinct
+// values were generated for the parameter, nil otherwise.
+//
+// Example usage:
+//
+//	results, _ := NewPBTest(func(b bool) bool { return b }).
+//	    WithIterations(100).WithPredicates(pred).Run()
+//	if err := RequireDistinctInputs(results, 0, 2); err != nil {
+//	    t.Fatal(err) // both true and false must have been tested
+//	}
+func RequireDistinctInputs(results []PBTestOut, paramIndex, minDistinct int) error {
+	var distinct []any
+	hashed := map[any]struct{}{}
+	for _, result := range results {
+		if paramIndex < 0 || paramIndex >= len(result.Inputs) {
+			continue
+		}
+		input := result.Inputs[paramIndex]
+		if input != nil && reflect.TypeOf(input).Comparable() {
+			if _, found := hashed[input]; !found {
+				hashed[input] = struct{}{}
+				distinct = append(distinct, input)
+			}
+			continue
+		}
+		if !containsDeepEqual(distinct, input) {
+			distinct = append(distinct, input)
+		}
+	}
+	if len(distinct) < minDistinct {
+		return InsufficientInputDiversityError{ParamIndex: paramIndex, Distinct: len(distinct), MinDistinct: minDistinct}
+	}
+	return nil
+}
+
+// CheckZeroInputs checks that no parameter was the zero value in more than maxFraction
+// of the results of a run, which usually means its attributes are misconfigured and the
+// generator fell back to zero values.
+//
+// Parameters:
+//   - results: The results of a Run, which record the inputs of every iteration
+//   - maxFraction: The tolerated fraction of zero values, between 0 and 1
+//
+// Returns a ZeroInputsError for the first parameter over the limit, nil otherwise.
+//
+// Example usage:
+//
+//	results, _ := NewPBTest(f).WithIterations(100).WithPredicates(pred).Run()
+//	if err := CheckZeroInputs(results, 0.5); err != nil {
+//	    t.Fatal(err)
+//	}
+func CheckZeroInputs(results []PBTestOut, maxFraction float64) error {
+	var counts zeroCounter
+	for _, result := range results {
+		counts.add(result.Inputs)
+	}
+	if errs := counts.errors(maxFraction); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// zeroCounter counts, per parameter position, how many input tuples held the zero value,
+// so that runs can check for degenerate inputs without keeping the inputs themselves.
+type zeroCounter struct {
+	zeros, totals []int
+}
+
+// add counts the arguments of one input tuple.
+func (c *zeroCounter) add(tuple []any) {
+	for i, input := range tuple {
+		if i >= len(c.totals) {
+			c.zeros, c.totals = append(c.zeros, 0), append(c.totals, 0)
+		}
+		c.totals[i]++
+		if v := reflect.ValueOf(input); !v.IsValid() || v.IsZero() {
+			c.zeros[i]++
+		}
+	}
+}
+
+// errors returns a ZeroInputsError for every parameter position that held the zero value
+// in more than maxFraction of the counted input tuples.
+func (c *zeroCounter) errors(maxFraction float64) (errs []ZeroInputsError) {
+	for i, total := range c.totals {
+		if float64(c.zeros[i]) > maxFraction*float64(total) {
+			errs = append(errs, ZeroInputsError{ParamIndex: i, Zero: c.zeros[i], Total: total, MaxFraction: maxFraction})
+		}
+	}
+	return errs
+}
+
+// formatLabels renders a label histogram as "label percent (count)" entries, the most
+// frequent label first and ties in label order.
+func formatLabels(labels map[string]int) string {
+	names := slices.Collect(maps.Keys(labels))
+	total := 0
+	for _, count := range labels {
+		total += count
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		if c := cmp.Compare(labels[b], labels[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	entries := make([]string, len(names))
+	for i, name := range names {
+		entries[i] = fmt.Sprintf("%s %.1f%% (%d)", name, 100*float64(labels[name])/float64(total), labels[name])
+	}
+	return strings.Join(entries, ", ")
+}
+
+// containsDeepEqual reports whether values holds an element deeply equal to v.
+func containsDeepEqual(values []any, v any) bool {
+	for _, existing := range values {
+		if reflect.DeepEqual(existing, v) {
+			return true
+		}
+	}
+	return false
+}
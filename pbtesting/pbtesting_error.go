@@ -3,6 +3,7 @@ package pbtesting
 import (
 	"errors"
 	"fmt"
+	"reflect"
 
 	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
 )
@@ -73,3 +74,213 @@ type InvalidFunctionProvidedError struct {
 func (ifp InvalidFunctionProvidedError) Error() string {
 	return fmt.Sprintf("Invalid function provided to pbt, function: [%v]", ifp.f)
 }
+
+// InsufficientInputDiversityError is returned by RequireDistinctInputs when a parameter
+// took fewer distinct values across a run than required.
+//
+// Fields:
+//   - ParamIndex: The index of the inspected parameter
+//   - Distinct: The number of distinct values that were generated
+//   - MinDistinct: The number of distinct values that was required
+//
+// Example scenario:
+//
+//	// A bool parameter that was false in every iteration
+//	err := RequireDistinctInputs(results, 0, 2)
+//	// Returns InsufficientInputDiversityError{ParamIndex: 0, Distinct: 1, MinDistinct: 2}
+type InsufficientInputDiversityError struct {
+	ParamIndex  int
+	Distinct    int
+	MinDistinct int
+}
+
+func (i InsufficientInputDiversityError) Error() string {
+	return fmt.Sprintf("parameter %d took %d distinct values, expected at least %d", i.ParamIndex, i.Distinct, i.MinDistinct)
+}
+
+// NotEndomorphicError is returned by relational checks that feed a function's output
+// back in as its input when the function does not have the shape func(T) T.
+//
+// Fields:
+//   - f: The function that was provided
+//
+// Example scenario:
+//
+//	_, err := CheckIdempotentOutput(strconv.Itoa, 100, nil)
+//	// Returns NotEndomorphicError, func(int) string cannot take its own output
+type NotEndomorphicError struct {
+	f any
+}
+
+func (ne NotEndomorphicError) Error() string {
+	return fmt.Sprintf("function must have the shape func(T) T, got %T", ne.f)
+}
+
+// NotSliceEndomorphicError is returned by relational checks comparing a function's output
+// slice with its input slice when the function does not have the shape func([]T) []T.
+//
+// Fields:
+//   - f: The function that was provided
+//
+// Example scenario:
+//
+//	_, err := CheckPermutation(func(n int) int { return n }, 100, nil)
+//	// Returns NotSliceEndomorphicError, the function does not take a slice
+type NotSliceEndomorphicError struct {
+	f any
+}
+
+func (ne NotSliceEndomorphicError) Error() string {
+	return fmt.Sprintf("function must have the shape func([]T) []T, got %T", ne.f)
+}
+
+// NotSliceFunctionError is returned by relational checks comparing a function's output
+// slice with its input slice when the function does not have the shape func([]T) []U.
+//
+// Fields:
+//   - f: The function that was provided
+//
+// Example scenario:
+//
+//	_, err := CheckLengthRelation(strings.ToUpper, nil, 100, nil)
+//	// Returns NotSliceFunctionError, the function takes and returns a string
+type NotSliceFunctionError struct {
+	f any
+}
+
+func (ne NotSliceFunctionError) Error() string {
+	return fmt.Sprintf("function must have the shape func([]T) []U, got %T", ne.f)
+}
+
+// SignatureMismatchError is returned by an OracleTest when the candidate and reference
+// functions do not have identical types, so they cannot be called with the same inputs.
+//
+// Fields:
+//   - candidate: The candidate function that was provided
+//   - reference: The reference function that was provided
+//
+// Example scenario:
+//
+//	_, err := NewOracleTest(strconv.Itoa, fmt.Sprint).Run()
+//	// Returns SignatureMismatchError, func(int) string differs from func(...any) string
+type SignatureMismatchError struct {
+	candidate any
+	reference any
+}
+
+func (sme SignatureMismatchError) Error() string {
+	return fmt.Sprintf("candidate and reference must have the same signature, got %T and %T", sme.candidate, sme.reference)
+}
+
+// MethodNotFoundError is returned by Run for a test built with NewPBTestMethod when the
+// receiver is nil or has no exported method with the given name.
+//
+// Fields:
+//   - receiver: The receiver that was provided or generated
+//   - method: The requested method name
+//
+// Example scenario:
+//
+//	_, err := NewPBTestMethod(Stack{}, "Push").Run()
+//	// Returns MethodNotFoundError, Push has a pointer receiver and is not in Stack's method set
+type MethodNotFoundError struct {
+	receiver any
+	method   string
+}
+
+func (mnf MethodNotFoundError) Error() string {
+	return fmt.Sprintf("method %s not found on receiver of type %T", mnf.method, mnf.receiver)
+}
+
+// ZeroInputsError reports a parameter that was the zero value in more than the tolerated
+// fraction of iterations, a hint that its attributes are misconfigured.
+//
+// Fields:
+//   - ParamIndex: The index of the inspected parameter
+//   - Zero: The number of iterations in which the parameter was the zero value
+//   - Total: The number of iterations inspected
+//   - MaxFraction: The tolerated fraction of zero values
+//
+// Example scenario:
+//
+//	// IntegerAttributesImpl{Min: -10, Max: -1} is rejected and always yields 0
+//	err := CheckZeroInputs(results, 0.5)
+//	// Returns ZeroInputsError{ParamIndex: 0, Zero: 100, Total: 100, MaxFraction: 0.5}
+type ZeroInputsError struct {
+	ParamIndex  int
+	Zero        int
+	Total       int
+	MaxFraction float64
+}
+
+func (z ZeroInputsError) Error() string {
+	return fmt.Sprintf("parameter %d was the zero value in %d of %d iterations, more than %g of them; check its attributes",
+		z.ParamIndex, z.Zero, z.Total, z.MaxFraction)
+}
+
+// UnsupportedExportFormatError is returned by ExportResults for a format other than
+// "json" or "csv".
+//
+// Fields:
+//   - Format: The requested format
+type UnsupportedExportFormatError struct {
+	Format string
+}
+
+func (uef UnsupportedExportFormatError) Error() string {
+	return fmt.Sprintf("unsupported export format %q, expected \"json\" or \"csv\"", uef.Format)
+}
+
+// AssumptionTooStrictError is returned by Run when the predicates set with WithAssumption
+// discarded more inputs than WithMaxDiscardRatio tolerates, so that the few inputs that
+// were actually tested say little about the property.
+//
+// Fields:
+//   - Discarded: The number of inputs discarded
+//   - Total: The number of inputs generated; with none, 100% is reported
+//
+// Example scenario:
+//
+//	// Assuming both integers are equal, with inputs drawn from [-100, 100]
+//	_, err := test.WithAssumption(equalInputs{}).WithMaxDiscardRatio(0.9).Run()
+//	// Returns AssumptionTooStrictError: assumption too strict: discarded 99% of inputs
+type AssumptionTooStrictError struct {
+	Discarded uint
+	Total     uint
+}
+
+func (ats AssumptionTooStrictError) Error() string {
+	percent := uint(100)
+	if ats.Total > 0 {
+		percent = ats.Discarded * 100 / ats.Total
+	}
+	return fmt.Sprintf("assumption too strict: discarded %d%% of inputs (%d of %d)",
+		percent, ats.Discarded, ats.Total)
+}
+
+// UnsupportedParameterError is returned by Validate, Run and RunWithAttributes when the
+// attributes cannot generate values for a parameter of the function under test, before
+// any iteration runs.
+//
+// Fields:
+//   - Index: The position of the parameter
+//   - Type: The parameter type
+//   - Reason: Why no value can be generated for it
+//   - Err: The underlying attributes error, if any
+//
+// Example scenario:
+//
+//	_, err := NewPBTest(func(n int, at time.Time) bool { return true }).Run()
+//	// Returns UnsupportedParameterError: param 1 expects time.Time but the attributes generate struct {...}
+type UnsupportedParameterError struct {
+	Index  int
+	Type   reflect.Type
+	Reason string
+	Err    error
+}
+
+func (upe UnsupportedParameterError) Error() string {
+	return fmt.Sprintf("param %d expects %v but %s", upe.Index, upe.Type, upe.Reason)
+}
+
+func (upe UnsupportedParameterError) Unwrap() error { return upe.Err }
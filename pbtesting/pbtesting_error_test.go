@@ -103,3 +103,35 @@ func TestPredefinedErrorsAreDifferent(t *testing.T) {
 		t.Error("Expected predefined errors to not be equal")
 	}
 }
+
+func TestInsufficientInputDiversityError(t *testing.T) {
+	err := InsufficientInputDiversityError{ParamIndex: 1, Distinct: 1, MinDistinct: 2}
+	expectedMsg := "parameter 1 took 1 distinct values, expected at least 2"
+	if err.Error() != expectedMsg {
+		t.Errorf("Expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+}
+
+func TestNotEndomorphicError(t *testing.T) {
+	err := NotEndomorphicError{f: func(int) string { return "" }}
+	expectedMsg := "function must have the shape func(T) T, got func(int) string"
+	if err.Error() != expectedMsg {
+		t.Errorf("Expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+}
+
+func TestNotSliceEndomorphicError(t *testing.T) {
+	err := NotSliceEndomorphicError{f: func(int) int { return 0 }}
+	expectedMsg := "function must have the shape func([]T) []T, got func(int) int"
+	if err.Error() != expectedMsg {
+		t.Errorf("Expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+}
+
+func TestNotSliceFunctionError(t *testing.T) {
+	err := NotSliceFunctionError{f: func(string) string { return "" }}
+	expectedMsg := "function must have the shape func([]T) []U, got func(string) string"
+	if err.Error() != expectedMsg {
+		t.Errorf("Expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+}
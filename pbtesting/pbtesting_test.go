@@ -1,10 +1,20 @@
 package pbtesting
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
 	p "github.com/laiambryant/gotestutils/pbtesting/properties/predicates"
 )
 
@@ -441,6 +451,106 @@ func TestFilterPBTTestOut(t *testing.T) {
 	}
 }
 
+func TestPredicateStats(t *testing.T) {
+	lowerHalf := mockPredicateForAttrTest{minValue: 0, maxValue: 50}
+	results, err := NewPBTest(func(n int) int { return n }).
+		WithArgAttributes(attributes.IntegerAttributesImpl[int]{Min: 0, Max: 100}).
+		WithIterations(200).
+		WithPredicates(mockPredicate{shouldPass: true, name: "always"}, lowerHalf).
+		Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stats := PredicateStats(results)
+	if stats["always"] != (PredicateStat{Pass: 200}) {
+		t.Errorf("expected the always passing predicate to pass 200 times, got %+v", stats["always"])
+	}
+	ranged := stats[fmt.Sprint(lowerHalf)]
+	if ranged.Pass+ranged.Fail != 200 || ranged.Pass == 0 || ranged.Fail == 0 {
+		t.Errorf("expected the range predicate to both pass and fail, got %+v", ranged)
+	}
+	if len(stats) != 2 {
+		t.Errorf("expected one entry per predicate, got %v", stats)
+	}
+	if got := PredicateStats([]PBTestOut{{RerunOutput: 1}}); len(got) != 0 {
+		t.Errorf("expected determinism failures not to be counted, got %v", got)
+	}
+}
+
+func TestWithMaxResults(t *testing.T) {
+	lowerHalf := mockPredicateForAttrTest{minValue: 0, maxValue: 50}
+	test := NewPBTest(func(n int) int { return n }).WithIterations(300).WithPredicates(lowerHalf).WithMaxResults(5)
+	results, err := test.RunWithAttributes(attributes.NewFTAttributes(attributes.WithIntegerRange(0, 100)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	passed, failed := test.ResultCounts()
+	if passed+failed != 300 || passed == 0 || failed < 5 {
+		t.Fatalf("expected every result to be counted, got %d passed and %d failed", passed, failed)
+	}
+	if len(results) != 5 || len(FilterPBTTestOut(results)) != 5 {
+		t.Errorf("expected only the first 5 failures to be retained, got %d results", len(results))
+	}
+	all, _ := test.WithMaxResults(0).Run()
+	if passed, failed := test.ResultCounts(); uint(len(all)) != passed+failed {
+		t.Errorf("expected every result to be retained by default, got %d of %d", len(all), passed+failed)
+	}
+}
+
+func TestWithClassifier(t *testing.T) {
+	sign := func(inputs []any) string {
+		if inputs[0].(int) < 0 {
+			return "negative"
+		}
+		return "non-negative"
+	}
+	test := NewPBTest(func(n int) int { return n }).
+		WithT(t).
+		WithIterations(200).
+		WithClassifier(sign).
+		WithPredicates(mockPredicate{shouldPass: true, name: "always"})
+	results, err := test.Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	labels := test.Labels()
+	if labels["negative"]+labels["non-negative"] != 200 || len(labels) != 2 {
+		t.Errorf("expected 200 iterations split between both labels, got %v", labels)
+	}
+	for _, result := range results {
+		if result.Label != sign(result.Inputs) {
+			t.Errorf("expected label %q for inputs %v, got %q", sign(result.Inputs), result.Inputs, result.Label)
+		}
+	}
+	if got := formatLabels(map[string]int{"b": 1, "a": 1, "c": 2}); got != "c 50.0% (2), a 25.0% (1), b 25.0% (1)" {
+		t.Errorf("unexpected label histogram %q", got)
+	}
+	if NewPBTest(func(n int) int { return n }).WithIterations(1).Labels() != nil {
+		t.Error("expected no labels without a classifier")
+	}
+}
+
+func TestWithClassifierSeesGeneratedInputs(t *testing.T) {
+	sorted := func(inputs []any) string {
+		if slices.IsSorted(inputs[0].([]int)) {
+			return "sorted"
+		}
+		return "unsorted"
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.SliceAttr = attributes.SliceAttributes{MinLen: 5, MaxLen: 5, ElementAttrs: attributes.IntegerAttributesImpl[int]{Min: 0, Max: 1000}}
+	test := NewPBTest(func(s []int) int { slices.Sort(s); return s[0] }).
+		WithIterations(100).
+		WithClassifier(sorted).
+		WithPredicates(mockPredicate{shouldPass: true, name: "always"})
+	if _, err := test.RunWithAttributes(attrs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if test.Labels()["unsorted"] == 0 {
+		t.Errorf("expected the inputs to be classified before the function sorted them, got %v", test.Labels())
+	}
+}
+
 func TestMethodChaining(t *testing.T) {
 	pred := mockPredicate{shouldPass: true, name: "pred"}
 	pbt := NewPBTest(funcVariadicAnyToAny).
@@ -820,20 +930,26 @@ func TestRun_GenerateInputsError(t *testing.T) {
 	}
 }
 
-func TestRun_GenerateInputsErrorWithFunc(t *testing.T) {
-	funcWithFunc := func(f func() int) int {
-		return f()
+func TestRun_FuncParameter(t *testing.T) {
+	rec := &attributes.CallRecorder{}
+	attrs := attributes.NewFTAttributes()
+	attrs.FuncAttr = attributes.FuncAttributes{Recorder: rec}
+	calls := 0
+	apply := func(xs []int, f func(int) int) {
+		rec.Reset()
+		for _, x := range xs {
+			f(x)
+		}
+		if rec.Count() != len(xs) {
+			t.Errorf("expected %d recorded calls, got %v", len(xs), rec.Calls())
+		}
+		calls++
 	}
-
-	pbt := NewPBTest(funcWithFunc).WithIterations(2)
-	results, err := pbt.Run()
-
-	if err == nil {
-		t.Error("Expected error when GenerateInputs fails with function parameter")
+	if _, err := NewPBTest(apply).WithIterations(20).RunWithAttributes(attrs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-
-	if results != nil {
-		t.Errorf("Expected nil results when error occurs, got %v", results)
+	if calls != 20 {
+		t.Errorf("expected 20 calls with generated functions, got %d", calls)
 	}
 }
 
@@ -853,3 +969,674 @@ func TestRun_GenerateInputsErrorWithInterface(t *testing.T) {
 		t.Errorf("Expected nil results when error occurs, got %v", results)
 	}
 }
+
+func TestRun_RecordsInputs(t *testing.T) {
+	pbt := NewPBTest(f2).WithIterations(5).WithPredicates(mockPredicate{shouldPass: true, name: "pass"})
+	results, err := pbt.Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, result := range results {
+		if len(result.Inputs) != 2 {
+			t.Fatalf("expected 2 recorded inputs, got %v", result.Inputs)
+		}
+		if result.Output != result.Inputs[0].(int)+result.Inputs[1].(int) {
+			t.Errorf("expected output %v to match inputs %v", result.Output, result.Inputs)
+		}
+	}
+}
+
+func TestRequireDistinctInputs(t *testing.T) {
+	results := []PBTestOut{
+		{Inputs: []any{true, []int{1}}},
+		{Inputs: []any{true, []int{1}}},
+		{Inputs: []any{false, []int{2}}},
+		{Inputs: []any{}},
+	}
+	if err := RequireDistinctInputs(results, 0, 2); err != nil {
+		t.Errorf("expected two distinct bools to be enough, got %v", err)
+	}
+	if err := RequireDistinctInputs(results, 1, 2); err != nil {
+		t.Errorf("expected two distinct slices to be enough, got %v", err)
+	}
+	err := RequireDistinctInputs(results, 0, 3)
+	var diversityErr InsufficientInputDiversityError
+	if !errors.As(err, &diversityErr) || diversityErr.Distinct != 2 || diversityErr.MinDistinct != 3 {
+		t.Errorf("expected InsufficientInputDiversityError with 2 of 3 values, got %v", err)
+	}
+	if err := RequireDistinctInputs(results, 5, 1); err == nil {
+		t.Error("expected error for a parameter that was never recorded")
+	}
+}
+
+type divModIdentity struct{}
+
+func (divModIdentity) Verify(val any) bool {
+	r, ok := val.([]any)
+	if !ok || len(r) != 4 {
+		return false
+	}
+	return r[2].(int)*r[1].(int)+r[3].(int) == r[0].(int)
+}
+
+func TestWithTuplePredicates(t *testing.T) {
+	divMod := func(a, b int) (int, int, int, int) { return a, b, a / b, a % b }
+	attrs := attributes.NewFTAttributes()
+	attrs.IntegerAttr = attributes.IntegerAttributesImpl[int]{Min: 1, Max: 100}
+	results, err := NewPBTest(divMod).WithIterations(20).WithTuplePredicates(divModIdentity{}).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 20 {
+		t.Fatalf("expected one result per iteration, got %d", len(results))
+	}
+	for _, result := range results {
+		if !result.Ok {
+			t.Errorf("expected tuple predicate to pass for %v", result.Output)
+		}
+		if tuple, ok := result.Output.([]any); !ok || len(tuple) != 4 {
+			t.Errorf("expected the whole tuple as output, got %v", result.Output)
+		}
+	}
+}
+
+func TestWithTuplePredicates_IndexRelation(t *testing.T) {
+	bounds := func(a, b int) (int, int) { return min(a, b), max(a, b) }
+	minLEMax := p.TupleIndexRelation{I: 0, J: 1, Fn: func(a, b any) bool { return a.(int) <= b.(int) }}
+	results, err := NewPBTest(bounds).WithIterations(20).WithTuplePredicates(minLEMax).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failures := FilterPBTTestOut(results); len(failures) != 0 {
+		t.Errorf("expected min <= max for every input, got %v", failures)
+	}
+	swapped := func(a, b int) (int, int) { return max(a, b) + 1, min(a, b) }
+	results, _ = NewPBTest(swapped).WithIterations(5).WithTuplePredicates(minLEMax).Run()
+	if failures := FilterPBTTestOut(results); len(failures) != 5 {
+		t.Errorf("expected every swapped result to fail, got %d failures", len(failures))
+	}
+}
+
+func TestWithTuplePredicates_SingleValue(t *testing.T) {
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	results, _ := NewPBTest(f1).WithIterations(3).WithTuplePredicates(pred).Run()
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if _, ok := results[0].Output.(int); !ok {
+		t.Errorf("expected single values to be validated as-is, got %T", results[0].Output)
+	}
+}
+
+func TestWithPredicates_ResetsTupleMode(t *testing.T) {
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	pbt := NewPBTest(f2).WithTuplePredicates(pred).WithPredicates(pred)
+	if pbt.tuple {
+		t.Error("expected WithPredicates to restore element-wise validation")
+	}
+}
+
+func TestApplyFunction_Variadic(t *testing.T) {
+	sumAll := func(base int, nums ...int) int {
+		for _, n := range nums {
+			base += n
+		}
+		return base
+	}
+	pbt := NewPBTest(sumAll)
+	result, err := pbt.applyFunction(1)
+	if err != nil || result != 1 {
+		t.Errorf("expected 1 with no variadic args, got %v (err %v)", result, err)
+	}
+	result, err = pbt.applyFunction(1, 2, 3, int8(4))
+	if err != nil || result != 10 {
+		t.Errorf("expected 10 with variadic args, got %v (err %v)", result, err)
+	}
+	if _, err = pbt.applyFunction(); err == nil {
+		t.Error("expected an error when the fixed parameter is missing")
+	}
+}
+
+func TestApplyFunction_ArgumentCountMismatch(t *testing.T) {
+	if _, err := NewPBTest(f2).applyFunction(1); err == nil {
+		t.Error("expected an error for too few arguments")
+	}
+	if _, err := NewPBTest(f2).applyFunction(1, 2, 3); err == nil {
+		t.Error("expected an error for too many arguments")
+	}
+}
+
+func TestRun_VariadicFunction(t *testing.T) {
+	countArgs := func(prefix string, nums ...int) int { return len(nums) }
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	results, err := NewPBTest(countArgs).WithIterations(50).WithPredicates(pred).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, result := range results {
+		if result.Output.(int) != len(result.Inputs)-1 {
+			t.Errorf("expected %d variadic args to be spread, got %v", len(result.Inputs)-1, result.Output)
+		}
+	}
+}
+
+func TestRun_WithZeroFirst(t *testing.T) {
+	fn := func(n int, s string, xs []int) int { return n + len(s) + len(xs) }
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	results, err := NewPBTest(fn).WithIterations(5).WithPredicates(pred).WithZeroFirst(true).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	if !reflect.DeepEqual(results[0].Inputs, []any{0, "", []int(nil)}) || results[0].Output != 0 {
+		t.Errorf("expected the first iteration to use zero inputs, got %#v -> %v", results[0].Inputs, results[0].Output)
+	}
+}
+
+func TestApplyFunction_UntypedNilArgument(t *testing.T) {
+	fn := func(e error) bool { return e == nil }
+	result, err := NewPBTest(fn).applyFunction(nil)
+	if err != nil || result != true {
+		t.Errorf("expected nil to be passed as a typed zero value, got %v (err %v)", result, err)
+	}
+}
+
+func TestRun_WithDeterminismCheck(t *testing.T) {
+	calls := 0
+	flaky := func(n int) int { calls++; return n + calls }
+	results, err := NewPBTest(flaky).WithIterations(3).WithDeterminismCheck(true).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 determinism failures, got %d", len(results))
+	}
+	for _, r := range results {
+		if r.Ok || r.Output == r.RerunOutput || len(r.Inputs) != 1 {
+			t.Errorf("expected a failure recording both outputs, got %+v", r)
+		}
+	}
+	if calls != 6 {
+		t.Errorf("expected the function to be called twice per iteration, got %d calls", calls)
+	}
+}
+
+func TestRun_WithDeterminismCheck_Stable(t *testing.T) {
+	double := func(xs []int) []int {
+		out := make([]int, len(xs))
+		for i, x := range xs {
+			out[i] = 2 * x
+		}
+		return out
+	}
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	results, err := NewPBTest(double).WithIterations(10).WithPredicates(pred).WithDeterminismCheck(true).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 10 || len(FilterPBTTestOut(results)) != 0 {
+		t.Errorf("expected 10 passing results, got %d with %d failures", len(results), len(FilterPBTTestOut(results)))
+	}
+}
+
+func TestRun_WithImmutabilityCheck(t *testing.T) {
+	input := []int{3, 1, 2}
+	sortInPlace := func(xs []int, m map[string]int) int {
+		for i := range xs {
+			for j := i + 1; j < len(xs); j++ {
+				if xs[j] < xs[i] {
+					xs[i], xs[j] = xs[j], xs[i]
+				}
+			}
+		}
+		return xs[0]
+	}
+	results, err := NewPBTest(sortInPlace).WithIterations(3).WithFixedArg(0, input).WithImmutabilityCheck(true).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 immutability failures, got %d", len(results))
+	}
+	for _, r := range results {
+		if r.Ok || !reflect.DeepEqual(r.Mutated, []int{0}) || !reflect.DeepEqual(r.Inputs[0], []int{3, 1, 2}) {
+			t.Errorf("expected a failure reporting the unsorted first argument, got %+v", r)
+		}
+	}
+	if !reflect.DeepEqual(input, []int{3, 1, 2}) {
+		t.Errorf("expected the generated argument to be left untouched, got %v", input)
+	}
+
+	deleteAll := func(xs []int, m map[string]int) int { clear(m); return len(xs) }
+	results, _ = NewPBTest(deleteAll).WithIterations(1).WithFixedArg(1, map[string]int{"a": 1}).WithImmutabilityCheck(true).Run()
+	if len(results) != 1 || !reflect.DeepEqual(results[0].Mutated, []int{1}) {
+		t.Errorf("expected the cleared map to be reported, got %+v", results)
+	}
+	if report := failureReport(results, 1, 1, 0); !strings.Contains(report, "mutated arguments: [1]") {
+		t.Errorf("expected the report to name the mutated argument, got:\n%s", report)
+	}
+
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	sum := func(xs []float64, p *[]float64) float64 { return float64(len(xs) + len(*p)) }
+	nan := []float64{math.NaN(), 1}
+	results, err = NewPBTest(sum).WithIterations(5).WithFixedArg(0, nan).WithFixedArg(1, &nan).
+		WithPredicates(pred).WithImmutabilityCheck(true).Run()
+	if err != nil || len(results) != 5 || len(FilterPBTTestOut(results)) != 0 {
+		t.Errorf("expected 5 passing results for a function leaving NaN arguments alone, got %+v (err %v)", results, err)
+	}
+}
+
+func TestPBTestOut_Passed(t *testing.T) {
+	if !(PBTestOut{Ok: true}).Passed() {
+		t.Error("expected a result with Ok set to pass")
+	}
+	if (PBTestOut{Ok: false}).Passed() {
+		t.Error("expected a result with Ok unset to fail")
+	}
+}
+
+type intStack struct {
+	items []int
+}
+
+func (s *intStack) Push(n int) int {
+	s.items = append(s.items, n)
+	return len(s.items)
+}
+
+type sizeAtMost struct{ max int }
+
+func (p sizeAtMost) Verify(v any) bool { return v.(int) <= p.max }
+
+func TestNewPBTestMethod(t *testing.T) {
+	stack := &intStack{}
+	results, err := NewPBTestMethod(stack, "Push").WithIterations(5).WithPredicates(sizeAtMost{max: 1}).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stack.items) != 5 {
+		t.Errorf("expected the shared receiver to receive 5 pushes, got %d", len(stack.items))
+	}
+	if failures := FilterPBTTestOut(results); len(failures) != 4 {
+		t.Errorf("expected the stack to grow past one element on a shared receiver, got %d failures", len(failures))
+	}
+}
+
+func TestNewPBTestMethod_WithReceiverAttributes(t *testing.T) {
+	fresh := attributes.PointerAttributes{Depth: 1, Inner: attributes.StructAttributes{TargetType: reflect.TypeOf(intStack{})}}
+	results, err := NewPBTestMethod(nil, "Push").WithReceiverAttributes(fresh).WithIterations(10).WithPredicates(sizeAtMost{max: 1}).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 10 || len(FilterPBTTestOut(results)) != 0 {
+		t.Errorf("expected every push to hit an empty, freshly generated stack, got %v", results)
+	}
+}
+
+func TestNewPBTestMethod_MethodNotFound(t *testing.T) {
+	for _, receiver := range []any{nil, intStack{}, &intStack{}} {
+		_, err := NewPBTestMethod(receiver, "Pop").Run()
+		if !errors.As(err, new(MethodNotFoundError)) {
+			t.Errorf("expected MethodNotFoundError for %T, got %v", receiver, err)
+		}
+	}
+	if _, err := NewPBTestMethod(intStack{}, "Push").Run(); err == nil || err.Error() != "method Push not found on receiver of type pbtesting.intStack" {
+		t.Errorf("expected the pointer-receiver method to be missing on a value, got %v", err)
+	}
+}
+
+func TestCheckZeroInputs(t *testing.T) {
+	attrs := attributes.NewFTAttributes()
+	// An inverted range always generates zero
+	attrs.IntegerAttr = attributes.IntegerAttributesImpl[int]{Min: 1, Max: -1}
+	attrs.StringAttr = attributes.StringAttributes{MinLen: 1, MaxLen: 5}
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	test := NewPBTest(func(n int, s string) int { return n + len(s) }).WithIterations(20).WithPredicates(pred)
+	results, err := test.WithT(t).WithZeroInputWarning(0.5).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = CheckZeroInputs(results, 0.5)
+	want := ZeroInputsError{ParamIndex: 0, Zero: 20, Total: 20, MaxFraction: 0.5}
+	if err != want {
+		t.Errorf("expected %v, got %v", want, err)
+	}
+	var counts zeroCounter
+	for _, tuple := range [][]any{{0, "a"}, {1, "b"}, {nil, ""}} {
+		counts.add(tuple)
+	}
+	if errs := counts.errors(0.5); len(errs) != 1 || errs[0].ParamIndex != 0 || errs[0].Zero != 2 {
+		t.Errorf("expected only parameter 0 to exceed the limit, got %v", errs)
+	}
+	if err := CheckZeroInputs(results, 1); err != nil {
+		t.Errorf("expected no error when every value may be zero, got %v", err)
+	}
+	if want.Error() != "parameter 0 was the zero value in 20 of 20 iterations, more than 0.5 of them; check its attributes" {
+		t.Errorf("unexpected error message: %s", want.Error())
+	}
+}
+
+func TestRun_WithSeedCorpus(t *testing.T) {
+	fn := func(a, b int) int { return a + b }
+	pred := mockPredicate{shouldPass: true, name: "pred"}
+	results, err := NewPBTest(fn).WithSeedCorpus([]any{-7, 7}, []any{1000, 2000}).WithIterations(3).WithPredicates(pred).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(results[0].Inputs, []any{-7, 7}) || !reflect.DeepEqual(results[1].Inputs, []any{1000, 2000}) {
+		t.Errorf("expected the seeds to be replayed first, got %v and %v", results[0].Inputs, results[1].Inputs)
+	}
+}
+
+type firstInputBelow struct{ max int }
+
+func (p firstInputBelow) Verify(v any) bool { return v.([]any)[0].(int) < p.max }
+
+func TestRun_WithAssumption(t *testing.T) {
+	var called []int
+	fn := func(n int) int { called = append(called, n); return n }
+	pred := mockPredicate{shouldPass: false, name: "pred"}
+	attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(1, 100))
+	results, err := NewPBTest(fn).WithIterations(200).WithPredicates(pred).WithAssumption(firstInputBelow{max: 51}).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, n := range called {
+		if n > 50 {
+			t.Fatalf("expected inputs failing the assumption not to reach the function, got %d", n)
+		}
+	}
+	if len(results) != len(called) || len(called) == 0 || len(called) == 200 {
+		t.Errorf("expected one result per kept input, got %d results for %d calls", len(results), len(called))
+	}
+}
+
+func TestRun_WithMaxDiscardRatio(t *testing.T) {
+	fn := func(n int) int { return n }
+	attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(1, 100))
+	test := NewPBTest(fn).WithIterations(100).WithAssumption(firstInputBelow{max: 3}).WithMaxDiscardRatio(0.9)
+	_, err := test.RunWithAttributes(attrs)
+	var ats AssumptionTooStrictError
+	if !errors.As(err, &ats) {
+		t.Fatalf("expected AssumptionTooStrictError, got %v", err)
+	}
+	if ats.Total != 100 || ats.Discarded <= 90 {
+		t.Errorf("expected more than 90 of 100 inputs discarded, got %+v", ats)
+	}
+	if _, err := test.WithMaxDiscardRatio(1).RunWithAttributes(attrs); err != nil {
+		t.Errorf("expected no error when every input may be discarded, got %v", err)
+	}
+	want := "assumption too strict: discarded 98% of inputs (98 of 100)"
+	if got := (AssumptionTooStrictError{Discarded: 98, Total: 100}).Error(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	want = "assumption too strict: discarded 100% of inputs (0 of 0)"
+	if got := (AssumptionTooStrictError{}).Error(); got != want {
+		t.Errorf("expected %q without inputs, got %q", want, got)
+	}
+}
+
+type trueOutput struct{}
+
+func (trueOutput) Verify(val any) bool { return val == true }
+
+func TestRun_WithArgGroup(t *testing.T) {
+	overlaps := func(s1, e1, s2, e2 int) bool { return max(s1, s2) <= min(e1, e2) }
+	bounds := attributes.IntegerAttributesImpl[int]{Min: -50, Max: 50}
+	results, err := NewPBTest(overlaps).
+		WithArgGroup(0, attributes.IntervalAttributes{Bounds: bounds, Pair: attributes.IntervalOverlapping}).
+		WithIterations(100).
+		WithPredicates(trueOutput{}).
+		Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failures := FilterPBTTestOut(results); len(results) != 100 || len(failures) != 0 {
+		t.Errorf("expected every generated pair to overlap, got %d failures", len(failures))
+	}
+	held := NewPBTest(func(start int, at time.Time) bool { return true }).WithArgGroup(0, attributes.IntervalAttributes{Bounds: bounds})
+	if err := held.Validate(); err != nil {
+		t.Errorf("expected grouped parameters not to be checked individually, got %v", err)
+	}
+}
+
+func TestRun_WithUniqueArg(t *testing.T) {
+	store := map[int]bool{}
+	insert := func(id int) bool {
+		if store[id] {
+			return false
+		}
+		store[id] = true
+		return true
+	}
+	results, err := NewPBTest(insert).
+		WithUniqueArg(0, 0).
+		WithIterations(200).
+		WithPredicates(trueOutput{}).
+		RunWithAttributes(attributes.NewFTAttributes(attributes.WithIntegerRange(0, 10_000)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failures := FilterPBTTestOut(results); len(results) != 200 || len(failures) != 0 {
+		t.Errorf("expected no ID collision across iterations, got %d failures", len(failures))
+	}
+}
+
+func TestRun_WithFixedArg(t *testing.T) {
+	fn := func(a, b int) int { return a - b }
+	pred := mockPredicate{shouldPass: false, name: "pred"}
+	results, err := NewPBTest(fn).WithFixedArg(1, 7).WithIterations(20).WithPredicates(pred).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	varied := false
+	for _, r := range results {
+		if r.Inputs[1] != 7 {
+			t.Fatalf("expected the second argument to be fixed to 7, got %v", r.Inputs)
+		}
+		if r.Inputs[0] != results[0].Inputs[0] {
+			varied = true
+		}
+	}
+	if !varied {
+		t.Error("expected the first argument to keep being generated")
+	}
+}
+
+func TestRun_ErrorIsOnValueErrorOutputs(t *testing.T) {
+	errNegative := errors.New("negative")
+	check := func(n int) (int, error) {
+		if n < 0 {
+			return 0, fmt.Errorf("check %d: %w", n, errNegative)
+		}
+		return n, nil
+	}
+	results, err := NewPBTest(check).WithIterations(50).WithPredicates(p.ErrorIs{Target: errNegative}).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failures := FilterPBTTestOut(results); len(failures) != 0 {
+		t.Errorf("expected every returned error to wrap the sentinel, got %v", failures)
+	}
+	results, _ = NewPBTest(check).WithIterations(50).WithPredicates(p.ErrorIs{Target: io.EOF}).Run()
+	for _, failure := range FilterPBTTestOut(results) {
+		if !errors.Is(failure.Output.(error), errNegative) {
+			t.Fatalf("expected only the returned errors to fail, got %v", failure.Output)
+		}
+	}
+}
+
+type floatBetween struct{ min, max float64 }
+
+func (p floatBetween) Verify(v any) bool { return v.(float64) >= p.min && v.(float64) < p.max }
+
+func TestRun_ZeroParameterFunction(t *testing.T) {
+	calls := 0
+	fn := func() float64 { calls++; return rand.Float64() }
+	results, err := NewPBTest(fn).WithIterations(30).WithPredicates(floatBetween{0, 1}).WithZeroFirst(true).Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 30 || len(results) != 30 {
+		t.Fatalf("expected the function to be called once per iteration, got %d calls and %d results", calls, len(results))
+	}
+	for _, r := range results {
+		if !r.Passed() || r.Inputs == nil || len(r.Inputs) != 0 {
+			t.Fatalf("expected passing results with empty inputs, got %+v", r)
+		}
+	}
+}
+
+func TestRun_WithTimeout(t *testing.T) {
+	slow := func(n int) int { time.Sleep(5 * time.Millisecond); return n }
+	test := NewPBTest(slow).WithIterations(1_000_000).WithPredicates(mockPredicate{shouldPass: true}).WithTimeout(50 * time.Millisecond)
+	start := time.Now()
+	results, err := test.Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("expected Run to stop shortly after the timeout, took %v", elapsed)
+	}
+	if !test.Truncated() || len(results) == 0 || len(results) >= 1_000_000 {
+		t.Fatalf("expected a truncated run with partial results, got %d results (truncated %v)", len(results), test.Truncated())
+	}
+	if _, err := test.WithIterations(3).Run(); err != nil || test.Truncated() {
+		t.Errorf("expected a run completing in time not to be truncated, got %v", err)
+	}
+}
+
+func TestRun_ContextParameter(t *testing.T) {
+	var contexts []context.Context
+	fn := func(ctx context.Context, n int) error {
+		contexts = append(contexts, ctx)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	test := NewPBTest(fn).WithIterations(5).WithPredicates(p.ErrorIs{Target: context.DeadlineExceeded}).WithTimeout(20 * time.Millisecond)
+	results, err := test.Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(contexts) == 0 || len(FilterPBTTestOut(results)) != 0 {
+		t.Fatalf("expected calls to be cancelled by the deadline, got %d calls and %v", len(contexts), FilterPBTTestOut(results))
+	}
+	if !test.Truncated() {
+		t.Error("expected the run to be truncated once the deadline passed")
+	}
+	contexts = nil
+	if _, err := NewPBTest(func(ctx context.Context, n int) int { contexts = append(contexts, ctx); return n }).WithIterations(3).Run(); err != nil {
+		t.Fatalf("expected a context to be provided without a timeout, got %v", err)
+	}
+	if len(contexts) != 3 || contexts[0].Err() == nil {
+		t.Errorf("expected 3 calls with a context cancelled when Run returns, got %d", len(contexts))
+	}
+}
+
+func TestRun_ContextAttributes(t *testing.T) {
+	attrs := attributes.NewFTAttributes()
+	attrs.ContextAttr = attributes.ContextAttributes{CancelledProbability: 1}
+	var contexts []context.Context
+	fn := func(ctx context.Context, n int) error {
+		contexts = append(contexts, ctx)
+		return ctx.Err()
+	}
+	results, err := NewPBTest(fn).WithIterations(5).WithPredicates(p.ErrorIs{Target: context.Canceled}).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(contexts) != 5 || len(FilterPBTTestOut(results)) != 0 {
+		t.Errorf("expected 5 calls with already cancelled contexts, got %d calls and %v", len(contexts), FilterPBTTestOut(results))
+	}
+	attrs.ContextAttr = attributes.ContextAttributes{DeadlineProbability: 1, MaxDelay: time.Hour}
+	contexts = nil
+	if _, err := NewPBTest(fn).WithIterations(3).RunWithAttributes(attrs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(contexts) != 3 || !errors.Is(contexts[0].Err(), context.Canceled) {
+		t.Errorf("expected generated contexts derived from the run context, cancelled when Run returns, got %v", contexts)
+	}
+}
+
+type countingWriter struct{ N int }
+
+func (w *countingWriter) Write(b []byte) (int, error) { w.N += len(b); return len(b), nil }
+
+func TestRun_WithInterfaceImplementations(t *testing.T) {
+	writerType := reflect.TypeFor[io.Writer]()
+	write := func(w io.Writer, s string) string { fmt.Fprint(w, s); return fmt.Sprintf("%T", w) }
+	var upe UnsupportedParameterError
+	if _, err := NewPBTest(write).WithIterations(1).Run(); !errors.As(err, &upe) {
+		t.Fatalf("expected the io.Writer parameter to be unsupported without implementations, got %v", err)
+	}
+	test := NewPBTest(write).WithIterations(100).WithPredicates(mockPredicate{shouldPass: true}).
+		WithInterfaceImplementations(writerType,
+			attributes.PointerAttributes{Depth: 1, Inner: attributes.StructAttributes{TargetType: reflect.TypeOf(countingWriter{})}},
+			attributes.PointerAttributes{Depth: 1, Inner: attributes.StructAttributes{TargetType: reflect.TypeOf(bytes.Buffer{})}})
+	if err := test.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	results, err := test.Run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	seen := map[any]bool{}
+	for _, r := range results {
+		seen[r.Output] = true
+	}
+	if len(results) != 100 || !seen["*pbtesting.countingWriter"] || !seen["*bytes.Buffer"] {
+		t.Errorf("expected both writers to be generated over 100 results, got %d results with %v", len(results), seen)
+	}
+
+	invalid := NewPBTest(write).WithInterfaceImplementations(writerType, attributes.StructAttributes{TargetType: reflect.TypeOf(countingWriter{})})
+	if _, err := invalid.Run(); !errors.As(err, &upe) || upe.Index != 0 {
+		t.Errorf("expected an implementation with pointer-receiver methods only to be rejected, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var upe UnsupportedParameterError
+	_, err := NewPBTest(func(n int, at time.Time) bool { return at.IsZero() }).WithIterations(5).Run()
+	if !errors.As(err, &upe) || upe.Index != 1 || upe.Type != reflect.TypeOf(time.Time{}) {
+		t.Fatalf("expected UnsupportedParameterError for the time.Time parameter, got %v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "param 1 expects time.Time but the attributes generate struct") {
+		t.Errorf("unexpected message: %v", err)
+	}
+	if err := NewPBTest(func(ch chan int) {}).Validate(); !errors.As(err, &upe) || upe.Reason != "no attribute is registered for it" || upe.Err == nil {
+		t.Errorf("expected a missing attribute to be reported, got %v", err)
+	}
+	held := NewPBTest(func(n int, at time.Time) bool { return at.IsZero() }).WithFixedArg(1, time.Time{})
+	if err := held.Validate(); err != nil {
+		t.Errorf("expected fixed parameters not to be checked, got %v", err)
+	}
+	for _, f := range []any{f2, funcAnyToAny, func(ctx context.Context, xs ...int8) {}, nil, 42} {
+		if err := NewPBTest(f).Validate(); err != nil {
+			t.Errorf("expected %T to validate, got %v", f, err)
+		}
+	}
+}
+
+type fixedTimeAttributes struct{}
+
+func (fixedTimeAttributes) GetAttributes() any           { return fixedTimeAttributes{} }
+func (fixedTimeAttributes) GetReflectType() reflect.Type { return reflect.TypeOf(time.Time{}) }
+func (fixedTimeAttributes) GetDefaultImplementation() attributes.Attributes {
+	return fixedTimeAttributes{}
+}
+func (fixedTimeAttributes) GetRandomValue() any { return time.Unix(0, 0) }
+
+func TestRun_GlobalTypeRegistration(t *testing.T) {
+	timeType := reflect.TypeOf(time.Time{})
+	attributes.RegisterGlobalType(timeType, fixedTimeAttributes{})
+	defer attributes.RegisterGlobalType(timeType, nil)
+	results, err := NewPBTest(func(at time.Time) int64 { return at.Unix() }).WithIterations(5).WithPredicates(mockPredicate{shouldPass: true}).Run()
+	if err != nil {
+		t.Fatalf("expected the globally registered type to be generated, got %v", err)
+	}
+	if len(results) != 5 || results[0].Output != int64(0) {
+		t.Errorf("expected outputs computed from the registered times, got %v", results)
+	}
+}
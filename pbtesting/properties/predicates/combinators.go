@@ -0,0 +1,72 @@
+package predicates
+
+import "fmt"
+
+// AtLeastN checks that at least N of Preds hold for a value, for quorum-style
+// properties where an output must satisfy some, but not necessarily all, of several
+// overlapping guarantees. Every sub-predicate is evaluated; use Partition to see
+// which of them passed for a given value.
+//
+// Fields:
+//   - N: The minimum number of sub-predicates that must pass
+//   - Preds: The sub-predicates to evaluate
+//
+// Example usage:
+//
+//	p := AtLeastN{N: 2, Preds: []Predicate{ArraySorted{}, SliceContains{Element: 0}, nonEmpty}}
+//	p.Verify([]int{0, 1, 2}) // true, all three hold
+//	p.Verify([]int{2, 1})    // true, sorted fails but the other two hold
+type AtLeastN struct {
+	N     int
+	Preds []Predicate
+}
+
+func (p AtLeastN) Verify(val any) bool {
+	passed, _ := partition(val, p.Preds)
+	return len(passed) >= p.N
+}
+
+// Partition splits Preds into the sub-predicates that passed and those that failed
+// for val, preserving their order.
+func (p AtLeastN) Partition(val any) (passed, failed []Predicate) { return partition(val, p.Preds) }
+
+func (p AtLeastN) String() string { return fmt.Sprintf("AtLeastN(%d of %v)", p.N, p.Preds) }
+
+// ExactlyN checks that exactly N of Preds hold for a value. Every sub-predicate is
+// evaluated; use Partition to see which of them passed for a given value.
+//
+// Fields:
+//   - N: The exact number of sub-predicates that must pass
+//   - Preds: The sub-predicates to evaluate
+//
+// Example usage:
+//
+//	// exactly one of the mutually exclusive states must be set
+//	p := ExactlyN{N: 1, Preds: []Predicate{isPending, isDone, isFailed}}
+type ExactlyN struct {
+	N     int
+	Preds []Predicate
+}
+
+func (p ExactlyN) Verify(val any) bool {
+	passed, _ := partition(val, p.Preds)
+	return len(passed) == p.N
+}
+
+// Partition splits Preds into the sub-predicates that passed and those that failed
+// for val, preserving their order.
+func (p ExactlyN) Partition(val any) (passed, failed []Predicate) { return partition(val, p.Preds) }
+
+func (p ExactlyN) String() string { return fmt.Sprintf("ExactlyN(%d of %v)", p.N, p.Preds) }
+
+// partition evaluates every predicate against val and splits them by outcome.
+func partition(val any, preds []Predicate) (passed, failed []Predicate) {
+	for _, pred := range preds {
+		if pred.Verify(val) {
+			passed = append(passed, pred)
+		} else {
+			failed = append(failed, pred)
+		}
+	}
+	return passed, failed
+}
@@ -0,0 +1,39 @@
+package predicates
+
+import "testing"
+
+func TestAtLeastN(t *testing.T) {
+	p := AtLeastN{N: 2, Preds: []Predicate{ArraySorted{}, SliceContains{Element: 0}, SliceContains{Element: 2}}}
+	if !p.Verify([]int{0, 1, 2}) || !p.Verify([]int{2, 0}) {
+		t.Error("expected values satisfying at least two sub-predicates to pass")
+	}
+	if p.Verify([]int{3, 1}) {
+		t.Error("expected a value satisfying fewer than two sub-predicates to fail")
+	}
+	passed, failed := p.Partition([]int{2, 0})
+	if len(passed) != 2 || len(failed) != 1 || failed[0] != (ArraySorted{}) {
+		t.Errorf("unexpected partition: passed %v, failed %v", passed, failed)
+	}
+	if !(AtLeastN{}).Verify(1) {
+		t.Error("expected N = 0 to always pass")
+	}
+	if p.String() != "AtLeastN(2 of [ArraySorted SliceContains(0) SliceContains(2)])" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestExactlyN(t *testing.T) {
+	p := ExactlyN{N: 1, Preds: []Predicate{SliceContains{Element: 1}, SliceContains{Element: 2}}}
+	if !p.Verify([]int{1}) || !p.Verify([]int{2, 3}) {
+		t.Error("expected values satisfying exactly one sub-predicate to pass")
+	}
+	if p.Verify([]int{1, 2}) || p.Verify([]int{3}) {
+		t.Error("expected values satisfying zero or two sub-predicates to fail")
+	}
+	if passed, failed := p.Partition([]int{2}); len(passed) != 1 || len(failed) != 1 {
+		t.Errorf("unexpected partition: passed %v, failed %v", passed, failed)
+	}
+	if p.String() != "ExactlyN(1 of [SliceContains(1) SliceContains(2)])" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
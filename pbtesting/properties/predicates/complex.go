@@ -0,0 +1,151 @@
+package predicates
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ComplexPhaseRange checks that the phase of a complex value, math.Atan2(imag, real),
+// lies in an arc of the complex plane, as needed when fuzzing signal processing code.
+//
+// Fields:
+//   - Min: The start of the arc in radians
+//   - Max: The end of the arc in radians, reached going counterclockwise from Min
+//
+// The arc wraps around: Min and Max may lie outside [-π, π], and a Max lower than Min
+// describes the arc crossing the negative real axis, so {Min: 3π/4, Max: -3π/4} accepts
+// phases near ±π. An arc spanning 2π or more accepts every phase. Zero has phase 0 and
+// NaN parts never match. Non-complex values pass.
+//
+// Example usage:
+//
+//	ComplexPhaseRange{Min: -math.Pi / 4, Max: math.Pi / 4}.Verify(complex(1, 0.5)) // true
+//	ComplexPhaseRange{Min: 3 * math.Pi / 4, Max: -3 * math.Pi / 4}.Verify(complex(-1, 0.1)) // true
+type ComplexPhaseRange struct {
+	Min float64
+	Max float64
+}
+
+func (p ComplexPhaseRange) Verify(val any) bool {
+	c, ok := asComplex128(val)
+	if !ok {
+		return true
+	}
+	phase := math.Atan2(imag(c), real(c))
+	if math.IsNaN(phase) {
+		return false
+	}
+	if p.Max-p.Min >= 2*math.Pi {
+		return true
+	}
+	return normalizeAngle(phase-p.Min) <= normalizeAngle(p.Max-p.Min)
+}
+
+func (p ComplexPhaseRange) String() string {
+	return fmt.Sprintf("ComplexPhaseRange([%v, %v] rad)", p.Min, p.Max)
+}
+
+// normalizeAngle returns the angle a in radians brought into [0, 2π).
+func normalizeAngle(a float64) float64 {
+	a = math.Mod(a, 2*math.Pi)
+	if a < 0 {
+		a += 2 * math.Pi
+	}
+	return a
+}
+
+// HalfPlane selects one of the four half-planes bounded by an axis of the complex plane.
+type HalfPlane int
+
+const (
+	// RightHalfPlane holds the values with a non-negative real part.
+	RightHalfPlane HalfPlane = iota
+	// LeftHalfPlane holds the values with a non-positive real part.
+	LeftHalfPlane
+	// UpperHalfPlane holds the values with a non-negative imaginary part.
+	UpperHalfPlane
+	// LowerHalfPlane holds the values with a non-positive imaginary part.
+	LowerHalfPlane
+)
+
+func (h HalfPlane) String() string {
+	switch h {
+	case RightHalfPlane:
+		return "right"
+	case LeftHalfPlane:
+		return "left"
+	case UpperHalfPlane:
+		return "upper"
+	case LowerHalfPlane:
+		return "lower"
+	}
+	return fmt.Sprintf("HalfPlane(%d)", int(h))
+}
+
+// ComplexHalfPlane checks that a complex value lies in a half-plane, such as the poles of
+// a stable continuous-time system lying strictly in the left half-plane.
+//
+// Fields:
+//   - Plane: The half-plane the value must lie in
+//   - Strict: Excludes the bounding axis, so that RightHalfPlane requires a positive
+//     real part rather than a non-negative one
+//
+// NaN parts never match, and an unknown Plane matches nothing. Non-complex values pass.
+//
+// Example usage:
+//
+//	ComplexHalfPlane{Plane: LeftHalfPlane, Strict: true}.Verify(complex(-0.5, 2)) // true
+//	ComplexHalfPlane{Plane: LeftHalfPlane, Strict: true}.Verify(complex(0, 2))    // false
+type ComplexHalfPlane struct {
+	Plane  HalfPlane
+	Strict bool
+}
+
+func (p ComplexHalfPlane) Verify(val any) bool {
+	c, ok := asComplex128(val)
+	if !ok {
+		return true
+	}
+	if math.IsNaN(real(c)) || math.IsNaN(imag(c)) {
+		return false
+	}
+	var coord float64
+	switch p.Plane {
+	case RightHalfPlane:
+		coord = real(c)
+	case LeftHalfPlane:
+		coord = -real(c)
+	case UpperHalfPlane:
+		coord = imag(c)
+	case LowerHalfPlane:
+		coord = -imag(c)
+	default:
+		return false
+	}
+	if p.Strict {
+		return coord > 0
+	}
+	return coord >= 0
+}
+
+func (p ComplexHalfPlane) String() string {
+	if p.Strict {
+		return fmt.Sprintf("ComplexHalfPlane(%v, strict)", p.Plane)
+	}
+	return fmt.Sprintf("ComplexHalfPlane(%v)", p.Plane)
+}
+
+// asComplex128 converts complex values (including named types with a complex underlying
+// kind) to complex128. It reports false for any other value.
+func asComplex128(val any) (complex128, bool) {
+	v := reflect.ValueOf(val)
+	if !v.IsValid() {
+		return 0, false
+	}
+	switch v.Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		return v.Complex(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,70 @@
+package predicates
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComplexPhaseRange(t *testing.T) {
+	p := ComplexPhaseRange{Min: -math.Pi / 4, Max: math.Pi / 4}
+	if !p.Verify(complex(1, 0.5)) || !p.Verify(complex64(complex(1, -1))) || !p.Verify(complex(0, 0)) {
+		t.Error("expected phases within [-π/4, π/4] to pass")
+	}
+	if p.Verify(complex(0, 1)) || p.Verify(complex(-1, 0)) || p.Verify(complex(math.NaN(), 1)) {
+		t.Error("expected phases outside the arc and NaN to fail")
+	}
+	wrap := ComplexPhaseRange{Min: 3 * math.Pi / 4, Max: -3 * math.Pi / 4}
+	if !wrap.Verify(complex(-1, 0.1)) || !wrap.Verify(complex(-1, -0.1)) || !wrap.Verify(complex(-1, 0)) {
+		t.Error("expected the wrapped arc to accept phases near ±π")
+	}
+	if wrap.Verify(complex(1, 0)) || wrap.Verify(complex(0, 1)) {
+		t.Error("expected the wrapped arc to reject phases near 0")
+	}
+	shifted := ComplexPhaseRange{Min: 7 * math.Pi / 4, Max: 9 * math.Pi / 4}
+	if !shifted.Verify(complex(1, 0.5)) || shifted.Verify(complex(-1, 0)) {
+		t.Error("expected bounds outside [-π, π] to be normalized")
+	}
+	if !(ComplexPhaseRange{Min: 0, Max: 2 * math.Pi}).Verify(complex(-1, -1)) {
+		t.Error("expected a full circle to accept every phase")
+	}
+	for _, v := range []any{nil, 1.0, "1+1i"} {
+		if !p.Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+	if p.String() != "ComplexPhaseRange([-0.7853981633974483, 0.7853981633974483] rad)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestComplexHalfPlane(t *testing.T) {
+	cases := []struct {
+		p    ComplexHalfPlane
+		pass []complex128
+		fail []complex128
+	}{
+		{ComplexHalfPlane{Plane: RightHalfPlane}, []complex128{complex(1, -3), complex(0, 2)}, []complex128{complex(-1, 0)}},
+		{ComplexHalfPlane{Plane: LeftHalfPlane, Strict: true}, []complex128{complex(-0.5, 2)}, []complex128{complex(0, 2), complex(1, 0)}},
+		{ComplexHalfPlane{Plane: UpperHalfPlane}, []complex128{complex(-5, 1), complex(3, 0)}, []complex128{complex(0, -1)}},
+		{ComplexHalfPlane{Plane: LowerHalfPlane, Strict: true}, []complex128{complex(2, -1)}, []complex128{complex(2, 0), complex(math.NaN(), -1)}},
+		{ComplexHalfPlane{Plane: HalfPlane(9)}, nil, []complex128{complex(1, 1)}},
+	}
+	for _, c := range cases {
+		for _, v := range c.pass {
+			if !c.p.Verify(v) {
+				t.Errorf("expected %v to lie in %v", v, c.p)
+			}
+		}
+		for _, v := range c.fail {
+			if c.p.Verify(v) {
+				t.Errorf("expected %v not to lie in %v", v, c.p)
+			}
+		}
+	}
+	if !(ComplexHalfPlane{Plane: LeftHalfPlane}).Verify(3) {
+		t.Error("expected non-complex values to pass")
+	}
+	if s := (ComplexHalfPlane{Plane: LeftHalfPlane, Strict: true}).String(); s != "ComplexHalfPlane(left, strict)" {
+		t.Errorf("unexpected String(): %s", s)
+	}
+}
@@ -0,0 +1,70 @@
+package predicates
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrorIs checks that an error wraps Target, as reported by errors.Is. Multi-value outputs
+// are verified element by element, so for a function returning (T, error) the error is
+// checked on its own while the other values pass.
+//
+// Fields:
+//   - Target: The sentinel error the chain must contain
+//
+// Values that are not errors, including a nil error, pass.
+//
+// Example usage:
+//
+//	p := ErrorIs{Target: ErrInvalidInput}
+//	p.Verify(fmt.Errorf("parse %q: %w", s, ErrInvalidInput)) // true
+//	p.Verify(io.EOF)                                        // false
+type ErrorIs struct {
+	Target error
+}
+
+func (p ErrorIs) Verify(val any) bool {
+	err, ok := val.(error)
+	if !ok {
+		return true
+	}
+	return errors.Is(err, p.Target)
+}
+
+func (p ErrorIs) String() string { return fmt.Sprintf("ErrorIs(%v)", p.Target) }
+
+// ErrorAs checks that an error's chain holds an error assignable to the type Target points
+// to, as reported by errors.As. Target is only used for its type and is never written to.
+//
+// Fields:
+//   - Target: A non-nil pointer to an error type or interface type, as passed to errors.As
+//
+// Values that are not errors, including a nil error, pass. Every error fails when Target
+// is not a valid errors.As target.
+//
+// Example usage:
+//
+//	p := ErrorAs{Target: new(*fs.PathError)}
+//	_, err := os.Open("missing")
+//	p.Verify(err) // true
+type ErrorAs struct {
+	Target any
+}
+
+func (p ErrorAs) Verify(val any) bool {
+	err, ok := val.(error)
+	if !ok {
+		return true
+	}
+	t := reflect.TypeOf(p.Target)
+	if t == nil || t.Kind() != reflect.Pointer || reflect.ValueOf(p.Target).IsNil() {
+		return false
+	}
+	if elem := t.Elem(); elem.Kind() != reflect.Interface && !elem.Implements(reflect.TypeFor[error]()) {
+		return false
+	}
+	return errors.As(err, reflect.New(t.Elem()).Interface())
+}
+
+func (p ErrorAs) String() string { return fmt.Sprintf("ErrorAs(%T)", p.Target) }
@@ -0,0 +1,55 @@
+package predicates
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"testing"
+)
+
+var errInvalidInput = errors.New("invalid input")
+
+func TestErrorIs(t *testing.T) {
+	p := ErrorIs{Target: errInvalidInput}
+	if !p.Verify(errInvalidInput) || !p.Verify(fmt.Errorf("parse: %w", errInvalidInput)) {
+		t.Error("expected errors wrapping the target to pass")
+	}
+	if p.Verify(io.EOF) || p.Verify(errors.New("invalid input")) {
+		t.Error("expected errors not wrapping the target to fail")
+	}
+	var nilErr error
+	if !p.Verify(nilErr) || !p.Verify(42) {
+		t.Error("expected nil and non-error values to pass")
+	}
+	if p.String() != "ErrorIs(invalid input)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	p := ErrorAs{Target: new(*fs.PathError)}
+	_, err := os.Open("does-not-exist")
+	if !p.Verify(err) || !p.Verify(fmt.Errorf("load config: %w", err)) {
+		t.Error("expected errors holding a *fs.PathError to pass")
+	}
+	if p.Verify(io.EOF) {
+		t.Error("expected errors without a *fs.PathError to fail")
+	}
+	if !p.Verify(nil) || !p.Verify("text") {
+		t.Error("expected nil and non-error values to pass")
+	}
+	if !(ErrorAs{Target: new(interface{ Timeout() bool })}).Verify(os.ErrDeadlineExceeded) {
+		t.Error("expected an interface target to match")
+	}
+	var nilTarget *error
+	for _, target := range []any{nil, 42, new(int), nilTarget} {
+		if (ErrorAs{Target: target}).Verify(io.EOF) {
+			t.Errorf("expected invalid target %T to fail", target)
+		}
+	}
+	if p.String() != "ErrorAs(**fs.PathError)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
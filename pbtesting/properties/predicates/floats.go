@@ -0,0 +1,187 @@
+package predicates
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+)
+
+// FloatApproxEqual checks that a numeric value lies within an absolute distance of a target.
+//
+// Fields:
+//   - Target: The expected value
+//   - Epsilon: The largest accepted absolute difference, math.Abs(v-Target) <= Epsilon
+//
+// Integers, unsigned integers and floats are compared after conversion to float64. NaN
+// never matches. Non-numeric values pass.
+//
+// Example usage:
+//
+//	FloatApproxEqual{Target: 2, Epsilon: 1e-9}.Verify(math.Sqrt(2) * math.Sqrt(2)) // true
+type FloatApproxEqual struct {
+	Target  float64
+	Epsilon float64
+}
+
+func (p FloatApproxEqual) Verify(val any) bool {
+	f, ok := asFloat64(val)
+	if !ok {
+		return true
+	}
+	return math.Abs(f-p.Target) <= p.Epsilon
+}
+
+func (p FloatApproxEqual) String() string {
+	return fmt.Sprintf("FloatApproxEqual(%v ± %v)", p.Target, p.Epsilon)
+}
+
+// FloatApproxEqualRel checks that a numeric value lies within a relative tolerance of a
+// target, which suits values whose magnitude is not known in advance.
+//
+// Fields:
+//   - Target: The expected value
+//   - RelTol: The largest accepted difference relative to the larger magnitude,
+//     math.Abs(v-Target) <= RelTol*max(math.Abs(v), math.Abs(Target))
+//
+// Integers, unsigned integers and floats are compared after conversion to float64. NaN
+// never matches. Non-numeric values pass.
+//
+// Example usage:
+//
+//	FloatApproxEqualRel{Target: 1e12, RelTol: 1e-9}.Verify(1e12 + 1) // true
+type FloatApproxEqualRel struct {
+	Target float64
+	RelTol float64
+}
+
+func (p FloatApproxEqualRel) Verify(val any) bool {
+	f, ok := asFloat64(val)
+	if !ok {
+		return true
+	}
+	if f == p.Target {
+		return true
+	}
+	return math.Abs(f-p.Target) <= p.RelTol*math.Max(math.Abs(f), math.Abs(p.Target))
+}
+
+func (p FloatApproxEqualRel) String() string {
+	return fmt.Sprintf("FloatApproxEqualRel(%v, rel %v)", p.Target, p.RelTol)
+}
+
+// zeroExpectedEpsilon is the absolute tolerance WithinPercent falls back to when the
+// expected value is zero, where any relative tolerance would only accept zero itself.
+const zeroExpectedEpsilon = 1e-9
+
+// WithinPercent checks that a numeric value lies within a percentage of an expected value,
+// which suits estimation and approximation algorithms whose contract is "close enough".
+//
+// Fields:
+//   - Expected: The expected value
+//   - Percent: The largest accepted deviation in percent of Expected,
+//     math.Abs(v-Expected)/math.Abs(Expected) <= Percent/100
+//
+// When Expected is zero the value must instead be within an absolute 1e-9 of zero.
+// Integers, unsigned integers and floats are compared after conversion to float64. NaN
+// never matches. Non-numeric values pass.
+//
+// Example usage:
+//
+//	WithinPercent{Expected: 200, Percent: 5}.Verify(209) // true
+//	WithinPercent{Expected: 200, Percent: 5}.Verify(211) // false
+type WithinPercent struct {
+	Expected float64
+	Percent  float64
+}
+
+func (p WithinPercent) Verify(val any) bool {
+	f, ok := asFloat64(val)
+	if !ok {
+		return true
+	}
+	if p.Expected == 0 {
+		return math.Abs(f) <= zeroExpectedEpsilon
+	}
+	return math.Abs(f-p.Expected)/math.Abs(p.Expected) <= p.Percent/100
+}
+
+func (p WithinPercent) String() string {
+	return fmt.Sprintf("WithinPercent(%v ± %v%%)", p.Expected, p.Percent)
+}
+
+// SliceWithinStdDev checks that no element of a numeric array or slice is an outlier:
+// every element must lie within MaxSigma standard deviations of the mean of all
+// elements, which suits random generators and smoothing functions whose output is
+// judged by its statistical shape.
+//
+// Fields:
+//   - MaxSigma: The largest accepted distance from the mean, in (population) standard
+//     deviations
+//
+// Integers, unsigned integers and floats are converted to float64. Sequences whose
+// elements are all equal pass, since they have no spread to measure; otherwise a NaN
+// element fails. Since some element always lies at least one standard deviation from
+// the mean, a MaxSigma below 1 fails every other sequence. Values that are not arrays or
+// slices, empty sequences and sequences holding a non-numeric element pass.
+//
+// Example usage:
+//
+//	SliceWithinStdDev{MaxSigma: 2}.Verify([]float64{1, 2, 3, 2, 1})      // true
+//	SliceWithinStdDev{MaxSigma: 2}.Verify([]int{1, 1, 1, 1, 1, 1, 1, 50}) // false
+type SliceWithinStdDev struct {
+	MaxSigma float64
+}
+
+func (p SliceWithinStdDev) Verify(val any) bool {
+	elems, ok := sequenceElements(val)
+	if !ok || len(elems) == 0 {
+		return true
+	}
+	values := make([]float64, len(elems))
+	for i, elem := range elems {
+		if values[i], ok = asFloat64(elem); !ok {
+			return true
+		}
+	}
+	if slices.Min(values) == slices.Max(values) {
+		return true
+	}
+	var mean, variance float64
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(values)))
+	for _, v := range values {
+		if !(math.Abs(v-mean) <= p.MaxSigma*stdDev) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p SliceWithinStdDev) String() string {
+	return fmt.Sprintf("SliceWithinStdDev(%vσ)", p.MaxSigma)
+}
+
+// asFloat64 converts integers, unsigned integers and floats (including named types with
+// those underlying kinds) to float64. It reports false for any other value.
+func asFloat64(val any) (float64, bool) {
+	v := reflect.ValueOf(val)
+	if !v.IsValid() {
+		return 0, false
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,97 @@
+package predicates
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatApproxEqual(t *testing.T) {
+	p := FloatApproxEqual{Target: 2, Epsilon: 1e-9}
+	if !p.Verify(math.Sqrt(2) * math.Sqrt(2)) {
+		t.Error("expected sqrt(2)^2 to be approximately 2")
+	}
+	if !p.Verify(2) || !p.Verify(uint8(2)) || !p.Verify(float32(2)) {
+		t.Error("expected numeric kinds to be coerced")
+	}
+	if p.Verify(2.001) || p.Verify(math.NaN()) || p.Verify(math.Inf(1)) {
+		t.Error("expected distant, NaN and infinite values to fail")
+	}
+	for _, v := range []any{nil, "2", []float64{2}} {
+		if !p.Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+	if p.String() != "FloatApproxEqual(2 ± 1e-09)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestFloatApproxEqualRel(t *testing.T) {
+	p := FloatApproxEqualRel{Target: 1e12, RelTol: 1e-9}
+	if !p.Verify(1e12 + 1) {
+		t.Error("expected a small relative difference to pass")
+	}
+	if p.Verify(1.01e12) || p.Verify(math.NaN()) {
+		t.Error("expected a large relative difference and NaN to fail")
+	}
+	zero := FloatApproxEqualRel{Target: 0, RelTol: 0.1}
+	if !zero.Verify(0) || zero.Verify(1e-300) {
+		t.Error("expected only exact zero to match a zero target")
+	}
+	inf := FloatApproxEqualRel{Target: math.Inf(1), RelTol: 0.1}
+	if !inf.Verify(math.Inf(1)) {
+		t.Error("expected equal infinities to match")
+	}
+	if !p.Verify("text") {
+		t.Error("expected non-numeric values to pass")
+	}
+	if p.String() != "FloatApproxEqualRel(1e+12, rel 1e-09)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestWithinPercent(t *testing.T) {
+	p := WithinPercent{Expected: 200, Percent: 5}
+	if !p.Verify(200) || !p.Verify(210.0) || !p.Verify(float32(190)) || !p.Verify(uint16(205)) {
+		t.Error("expected values within 5% of 200 to pass")
+	}
+	if p.Verify(211) || p.Verify(189.9) || p.Verify(math.NaN()) {
+		t.Error("expected values beyond 5% of 200 and NaN to fail")
+	}
+	negative := WithinPercent{Expected: -100, Percent: 10}
+	if !negative.Verify(-91) || negative.Verify(-89) {
+		t.Error("expected the tolerance to be relative to |Expected|")
+	}
+	zero := WithinPercent{Expected: 0, Percent: 50}
+	if !zero.Verify(0) || !zero.Verify(1e-10) || zero.Verify(1e-3) {
+		t.Error("expected a zero Expected to fall back to an absolute epsilon")
+	}
+	if !p.Verify("text") || !p.Verify(nil) {
+		t.Error("expected non-numeric values to pass")
+	}
+	if p.String() != "WithinPercent(200 ± 5%)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestSliceWithinStdDev(t *testing.T) {
+	p := SliceWithinStdDev{MaxSigma: 2}
+	if !p.Verify([]float64{1, 2, 3, 2, 1}) || !p.Verify([3]uint8{4, 5, 6}) || !p.Verify([]float64{0.1, 0.1, 0.1}) {
+		t.Error("expected sequences without outliers to pass")
+	}
+	if p.Verify([]int{1, 1, 1, 1, 1, 1, 1, 50}) || p.Verify([]float64{1, math.NaN(), 2}) {
+		t.Error("expected an outlier and a NaN element to fail")
+	}
+	if !(SliceWithinStdDev{MaxSigma: 3}).Verify([]int{1, 1, 1, 1, 1, 1, 1, 50}) {
+		t.Error("expected a larger MaxSigma to accept the outlier")
+	}
+	if (SliceWithinStdDev{MaxSigma: 0.5}).Verify([]int{1, 2}) {
+		t.Error("expected a MaxSigma below 1 to fail a sequence with spread")
+	}
+	if !p.Verify([]int{}) || !p.Verify([]any{1, "x", 100}) || !p.Verify(42) || !p.Verify(nil) {
+		t.Error("expected empty, non-numeric and non-sequence values to pass")
+	}
+	if p.String() != "SliceWithinStdDev(2σ)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
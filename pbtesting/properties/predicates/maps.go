@@ -0,0 +1,110 @@
+package predicates
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/laiambryant/gotestutils/utils"
+)
+
+// MapKeyPredicates checks that every key of a map satisfies all of Preds.
+//
+// Fields:
+//   - Preds: The predicates every key must satisfy
+//   - SortKeys: If true and the key type is ordered, keys are visited in ascending order
+//     so that FirstViolation reports the same key on every run
+//
+// Values that are not maps pass.
+//
+// Example usage:
+//
+//	positiveKeys := MapKeyPredicates{Preds: []Predicate{positive}, SortKeys: true}
+//	positiveKeys.Verify(map[int]string{1: "a", -2: "b", -5: "c"}) // false
+//	positiveKeys.FirstViolation(map[int]string{1: "a", -2: "b", -5: "c"}) // -5, true
+type MapKeyPredicates struct {
+	Preds    []Predicate
+	SortKeys bool
+}
+
+func (p MapKeyPredicates) Verify(val any) bool {
+	_, found := p.FirstViolation(val)
+	return !found
+}
+
+// FirstViolation returns the first key that fails one of Preds, and whether there was one.
+func (p MapKeyPredicates) FirstViolation(val any) (any, bool) {
+	v := reflect.ValueOf(val)
+	for _, key := range mapKeys(v, p.SortKeys) {
+		if !satisfiesAll(key.Interface(), p.Preds) {
+			return key.Interface(), true
+		}
+	}
+	return nil, false
+}
+
+func (p MapKeyPredicates) String() string { return "MapKeyPredicates" }
+
+// MapValuePredicates checks that every value of a map satisfies all of Preds.
+//
+// Fields:
+//   - Preds: The predicates every value must satisfy
+//   - SortKeys: If true and the key type is ordered, entries are visited in ascending key
+//     order so that FirstViolation reports the same entry on every run
+//
+// Values that are not maps pass.
+//
+// Example usage:
+//
+//	nonEmpty := MapValuePredicates{Preds: []Predicate{nonEmptyString}, SortKeys: true}
+//	nonEmpty.FirstViolation(map[string]string{"b": "", "a": ""}) // "a", true
+type MapValuePredicates struct {
+	Preds    []Predicate
+	SortKeys bool
+}
+
+func (p MapValuePredicates) Verify(val any) bool {
+	_, found := p.FirstViolation(val)
+	return !found
+}
+
+// FirstViolation returns the key of the first entry whose value fails one of Preds, and
+// whether there was one.
+func (p MapValuePredicates) FirstViolation(val any) (any, bool) {
+	v := reflect.ValueOf(val)
+	for _, key := range mapKeys(v, p.SortKeys) {
+		if !satisfiesAll(v.MapIndex(key).Interface(), p.Preds) {
+			return key.Interface(), true
+		}
+	}
+	return nil, false
+}
+
+func (p MapValuePredicates) String() string { return "MapValuePredicates" }
+
+// mapKeys returns the keys of v, or nil when v is not a map. With sorted set and an
+// ordered key type the keys are returned in ascending order; otherwise they keep map
+// iteration order.
+func mapKeys(v reflect.Value, sorted bool) []reflect.Value {
+	if !v.IsValid() || v.Kind() != reflect.Map {
+		return nil
+	}
+	keys := make([]reflect.Value, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if sorted && utils.IsOrdered(v.Type().Key()) {
+		sort.Slice(keys, func(i, j int) bool { return utils.Less(keys[i].Interface(), keys[j].Interface()) })
+	}
+	return keys
+}
+
+// satisfiesAll reports whether val satisfies every predicate in preds.
+func satisfiesAll(val any, preds []Predicate) bool {
+	for _, pred := range preds {
+		if !pred.Verify(val) {
+			return false
+		}
+	}
+	return true
+}
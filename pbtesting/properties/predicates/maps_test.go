@@ -0,0 +1,59 @@
+package predicates
+
+import "testing"
+
+type nonNegativeInt struct{}
+
+func (nonNegativeInt) Verify(val any) bool { n, ok := val.(int); return !ok || n >= 0 }
+
+func TestMapKeyPredicates(t *testing.T) {
+	p := MapKeyPredicates{Preds: []Predicate{nonNegativeInt{}}}
+	if !p.Verify(map[int]string{0: "a", 3: "b"}) {
+		t.Error("expected non-negative keys to pass")
+	}
+	if p.Verify(map[int]string{1: "a", -2: "b"}) {
+		t.Error("expected a negative key to fail")
+	}
+	for _, v := range []any{nil, 42, []int{-1}} {
+		if !p.Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+}
+
+func TestMapKeyPredicatesSortKeys(t *testing.T) {
+	p := MapKeyPredicates{Preds: []Predicate{nonNegativeInt{}}, SortKeys: true}
+	m := map[int]string{1: "a", -2: "b", -5: "c", -3: "d", 7: "e"}
+	for i := 0; i < 50; i++ {
+		key, found := p.FirstViolation(m)
+		if !found || key != -5 {
+			t.Fatalf("expected the smallest violating key -5, got %v (found %v)", key, found)
+		}
+	}
+	if _, found := p.FirstViolation(map[int]string{1: "a"}); found {
+		t.Error("expected no violation")
+	}
+}
+
+func TestMapValuePredicatesSortKeys(t *testing.T) {
+	p := MapValuePredicates{Preds: []Predicate{nonNegativeInt{}}, SortKeys: true}
+	m := map[string]int{"d": -1, "b": -1, "c": 2, "e": -4}
+	for i := 0; i < 50; i++ {
+		key, found := p.FirstViolation(m)
+		if !found || key != "b" {
+			t.Fatalf("expected the first violating key in order to be b, got %v (found %v)", key, found)
+		}
+	}
+	if !p.Verify(map[string]int{"a": 1}) || p.Verify(m) {
+		t.Error("unexpected Verify result")
+	}
+}
+
+func TestMapPredicatesUnorderedKeys(t *testing.T) {
+	type point struct{ X, Y int }
+	p := MapValuePredicates{Preds: []Predicate{nonNegativeInt{}}, SortKeys: true}
+	key, found := p.FirstViolation(map[point]int{{1, 2}: -1, {3, 4}: 1})
+	if !found || key != (point{1, 2}) {
+		t.Errorf("expected the only violating key, got %v (found %v)", key, found)
+	}
+}
@@ -0,0 +1,121 @@
+package predicates
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// SliceContains checks that an array or slice holds at least one element deeply equal
+// to Element.
+//
+// Fields:
+//   - Element: The element that must be present, compared with reflect.DeepEqual
+//
+// Values that are not arrays or slices pass.
+//
+// Example usage:
+//
+//	// after s = append(s, x), s must contain x
+//	SliceContains{Element: 3}.Verify([]int{1, 2, 3}) // true
+//	SliceContains{Element: 4}.Verify([]int{1, 2, 3}) // false
+type SliceContains struct {
+	Element any
+}
+
+func (p SliceContains) Verify(val any) bool {
+	elems, ok := sequenceElements(val)
+	if !ok {
+		return true
+	}
+	return containsDeepEqual(elems, p.Element)
+}
+
+func (p SliceContains) String() string { return fmt.Sprintf("SliceContains(%v)", p.Element) }
+
+// SliceContainsAll checks that an array or slice holds, for every value in Elements, at
+// least one deeply equal element. Multiplicity is ignored, so Elements {1, 1} is
+// satisfied by a single 1.
+//
+// Fields:
+//   - Elements: The elements that must all be present, compared with reflect.DeepEqual
+//
+// Values that are not arrays or slices pass.
+//
+// Example usage:
+//
+//	SliceContainsAll{Elements: []any{1, 3}}.Verify([]int{1, 2, 3}) // true
+//	SliceContainsAll{Elements: []any{1, 4}}.Verify([]int{1, 2, 3}) // false
+type SliceContainsAll struct {
+	Elements []any
+}
+
+func (p SliceContainsAll) Verify(val any) bool {
+	elems, ok := sequenceElements(val)
+	if !ok {
+		return true
+	}
+	for _, want := range p.Elements {
+		if !containsDeepEqual(elems, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p SliceContainsAll) String() string {
+	return fmt.Sprintf("SliceContainsAll(%v)", p.Elements)
+}
+
+// SliceUniqueBy checks that the elements of an array or slice are distinct by a key,
+// such as "no two orders in the output share an ID".
+//
+// Fields:
+//   - KeyFn: Projects each element to the key that must be unique; nil compares the
+//     elements themselves
+//
+// Keys are compared with == when they are comparable and with reflect.DeepEqual otherwise.
+// Values that are not arrays or slices pass.
+//
+// Example usage:
+//
+//	byID := SliceUniqueBy{KeyFn: func(e any) any { return e.(Order).ID }}
+//	byID.Verify([]Order{{ID: 1}, {ID: 2}}) // true
+//	byID.Verify([]Order{{ID: 1}, {ID: 1}}) // false
+type SliceUniqueBy struct {
+	KeyFn func(any) any
+}
+
+func (p SliceUniqueBy) Verify(val any) bool {
+	elems, ok := sequenceElements(val)
+	if !ok {
+		return true
+	}
+	hashed := map[any]bool{}
+	var others []any
+	for _, e := range elems {
+		key := e
+		if p.KeyFn != nil {
+			key = p.KeyFn(e)
+		}
+		if key != nil && !reflect.ValueOf(key).Comparable() {
+			if containsDeepEqual(others, key) {
+				return false
+			}
+			others = append(others, key)
+			continue
+		}
+		if hashed[key] {
+			return false
+		}
+		hashed[key] = true
+	}
+	return true
+}
+
+func (p SliceUniqueBy) String() string { return "SliceUniqueBy" }
+
+// containsDeepEqual reports whether any of elems is deeply equal to want.
+func containsDeepEqual(elems []any, want any) bool {
+	return slices.ContainsFunc(elems, func(e any) bool { return reflect.DeepEqual(e, want) })
+}
@@ -0,0 +1,71 @@
+package predicates
+
+import "testing"
+
+func TestSliceContains(t *testing.T) {
+	p := SliceContains{Element: 3}
+	if !p.Verify([]int{1, 2, 3}) || !p.Verify([2]int{3, 0}) {
+		t.Error("expected sequences holding the element to pass")
+	}
+	if p.Verify([]int{1, 2}) || p.Verify([]int{}) || p.Verify([]int64{3}) {
+		t.Error("expected sequences without a deeply equal element to fail")
+	}
+	if !(SliceContains{Element: []string{"a"}}).Verify([][]string{{"b"}, {"a"}}) {
+		t.Error("expected non-comparable elements to be compared deeply")
+	}
+	for _, v := range []any{nil, 3, map[int]int{3: 3}} {
+		if !p.Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+	if p.String() != "SliceContains(3)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestSliceContainsAll(t *testing.T) {
+	p := SliceContainsAll{Elements: []any{1, 3, 1}}
+	if !p.Verify([]int{3, 2, 1}) {
+		t.Error("expected a sequence holding every element to pass")
+	}
+	if p.Verify([]int{1, 2}) {
+		t.Error("expected a missing element to fail")
+	}
+	if !(SliceContainsAll{}).Verify([]int{}) {
+		t.Error("expected no required elements to pass")
+	}
+	if !p.Verify("not a slice") {
+		t.Error("expected non-sequences to pass")
+	}
+	if p.String() != "SliceContainsAll([1 3 1])" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestSliceUniqueBy(t *testing.T) {
+	type order struct {
+		ID   int
+		Tags []string
+	}
+	byID := SliceUniqueBy{KeyFn: func(e any) any { return e.(order).ID }}
+	if !byID.Verify([]order{{ID: 1}, {ID: 2, Tags: []string{"a"}}}) || !byID.Verify([]order{}) {
+		t.Error("expected elements with distinct keys to pass")
+	}
+	if byID.Verify([]order{{ID: 1}, {ID: 2}, {ID: 1, Tags: []string{"b"}}}) {
+		t.Error("expected a duplicated key to fail")
+	}
+	byTags := SliceUniqueBy{KeyFn: func(e any) any { return e.(order).Tags }}
+	if !byTags.Verify([]order{{Tags: []string{"a"}}, {Tags: []string{"b"}}}) || byTags.Verify([2]order{{Tags: []string{"a"}}, {Tags: []string{"a"}}}) {
+		t.Error("expected non-comparable keys to be compared deeply")
+	}
+	whole := SliceUniqueBy{}
+	if !whole.Verify([]any{1, "1", nil}) || whole.Verify([]any{nil, 2, nil}) {
+		t.Error("expected a nil KeyFn to compare whole elements")
+	}
+	if !byID.Verify("not a slice") || !byID.Verify(nil) {
+		t.Error("expected non-sequences to pass")
+	}
+	if byID.String() != "SliceUniqueBy" {
+		t.Errorf("unexpected String(): %s", byID.String())
+	}
+}
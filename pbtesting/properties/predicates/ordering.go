@@ -0,0 +1,124 @@
+package predicates
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/laiambryant/gotestutils/utils"
+)
+
+// ArraySorted checks that the elements of an array or slice are in non-decreasing order.
+//
+// Elements are compared with the built-in ordering for integers, unsigned integers,
+// floats and strings. Use ArraySortedFunc to order any other element type. Values that
+// are not arrays or slices, and sequences whose elements are not ordered, pass.
+//
+// Example usage:
+//
+//	ArraySorted{}.Verify([3]int{1, 2, 2}) // true
+//	ArraySorted{}.Verify([]int{3, 1})     // false
+type ArraySorted struct{}
+
+func (p ArraySorted) Verify(val any) bool {
+	elems, ok := sequenceElements(val)
+	if !ok || len(elems) == 0 || !utils.IsOrdered(reflect.TypeOf(elems[0])) {
+		return true
+	}
+	return isSorted(elems, utils.Less)
+}
+
+func (p ArraySorted) String() string { return "ArraySorted" }
+
+// ArraySortedFunc checks that the elements of an array or slice are in non-decreasing
+// order according to a user-provided comparator, which makes sortedness of structs and
+// other custom element types checkable.
+//
+// Fields:
+//   - Less: Reports whether a orders strictly before b
+//
+// Values that are not arrays or slices pass, as does any value when Less is nil.
+//
+// Example usage:
+//
+//	byAge := ArraySortedFunc{Less: func(a, b any) bool { return a.(Person).Age < b.(Person).Age }}
+//	byAge.Verify([]Person{{Age: 20}, {Age: 30}}) // true
+type ArraySortedFunc struct {
+	Less func(a, b any) bool
+}
+
+func (p ArraySortedFunc) Verify(val any) bool {
+	elems, ok := sequenceElements(val)
+	if !ok || p.Less == nil {
+		return true
+	}
+	return isSorted(elems, p.Less)
+}
+
+func (p ArraySortedFunc) String() string { return "ArraySortedFunc" }
+
+// SliceMonotonic checks that the elements of an array or slice are monotonic in the
+// direction given by Increasing. With Strict, equal adjacent elements fail, which tells
+// "strictly increasing" (no duplicates) apart from "non-decreasing" (duplicates allowed).
+//
+// Fields:
+//   - Strict: If true, adjacent elements must differ
+//   - Increasing: If true, elements must increase; otherwise they must decrease
+//
+// Elements are compared with the built-in ordering for integers, unsigned integers,
+// floats and strings. Values that are not arrays or slices, and sequences whose elements
+// are not ordered, pass.
+//
+// Example usage:
+//
+//	SliceMonotonic{Increasing: true}.Verify([]int{1, 2, 2})               // true
+//	SliceMonotonic{Strict: true, Increasing: true}.Verify([]int{1, 2, 2}) // false
+//	SliceMonotonic{Strict: true}.Verify([]int{3, 2, 1})                   // true
+type SliceMonotonic struct {
+	Strict     bool
+	Increasing bool
+}
+
+func (p SliceMonotonic) Verify(val any) bool {
+	elems, ok := sequenceElements(val)
+	if !ok || len(elems) == 0 || !utils.IsOrdered(reflect.TypeOf(elems[0])) {
+		return true
+	}
+	for i := 1; i < len(elems); i++ {
+		prev, next := elems[i-1], elems[i]
+		if !p.Increasing {
+			prev, next = next, prev
+		}
+		if utils.Less(next, prev) || (p.Strict && !utils.Less(prev, next)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p SliceMonotonic) String() string {
+	return fmt.Sprintf("SliceMonotonic(Strict=%t, Increasing=%t)", p.Strict, p.Increasing)
+}
+
+// isSorted reports whether no element orders strictly before its predecessor.
+func isSorted(elems []any, less func(a, b any) bool) bool {
+	for i := 1; i < len(elems); i++ {
+		if less(elems[i], elems[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sequenceElements returns the elements of an array or slice. It reports false for
+// any other kind of value.
+func sequenceElements(val any) ([]any, bool) {
+	v := reflect.ValueOf(val)
+	if !v.IsValid() || (v.Kind() != reflect.Array && v.Kind() != reflect.Slice) {
+		return nil, false
+	}
+	elems := make([]any, v.Len())
+	for i := range elems {
+		elems[i] = v.Index(i).Interface()
+	}
+	return elems, true
+}
@@ -0,0 +1,67 @@
+package predicates
+
+import "testing"
+
+type ageRecord struct {
+	Name string
+	Age  int
+}
+
+func TestArraySorted(t *testing.T) {
+	if !(ArraySorted{}).Verify([4]int{1, 2, 2, 5}) {
+		t.Error("expected non-decreasing array to pass")
+	}
+	if (ArraySorted{}).Verify([]string{"b", "a"}) {
+		t.Error("expected unsorted slice to fail")
+	}
+	for _, v := range []any{nil, 42, []ageRecord{{Age: 2}, {Age: 1}}} {
+		if !(ArraySorted{}).Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+}
+
+func TestArraySortedFunc(t *testing.T) {
+	byAge := ArraySortedFunc{Less: func(a, b any) bool { return a.(ageRecord).Age < b.(ageRecord).Age }}
+	if !byAge.Verify([]ageRecord{{"a", 1}, {"b", 1}, {"c", 3}}) {
+		t.Error("expected records sorted by age to pass")
+	}
+	if byAge.Verify([2]ageRecord{{"a", 3}, {"b", 1}}) {
+		t.Error("expected records out of order to fail")
+	}
+	if !(ArraySortedFunc{}).Verify([]int{2, 1}) {
+		t.Error("expected nil comparator to pass")
+	}
+}
+
+func TestSliceMonotonic(t *testing.T) {
+	cases := []struct {
+		p    SliceMonotonic
+		val  any
+		want bool
+	}{
+		{SliceMonotonic{Increasing: true}, []int{1, 2, 2, 5}, true},
+		{SliceMonotonic{Increasing: true}, []int{2, 1}, false},
+		{SliceMonotonic{Strict: true, Increasing: true}, []int{1, 2, 5}, true},
+		{SliceMonotonic{Strict: true, Increasing: true}, []int{1, 2, 2}, false},
+		{SliceMonotonic{}, [3]string{"c", "b", "b"}, true},
+		{SliceMonotonic{}, []string{"a", "b"}, false},
+		{SliceMonotonic{Strict: true}, []float64{3, 2, 1}, true},
+		{SliceMonotonic{Strict: true}, []float64{3, 3}, false},
+		{SliceMonotonic{Strict: true, Increasing: true}, []int{}, true},
+		{SliceMonotonic{Strict: true, Increasing: true}, []int{7}, true},
+	}
+	for _, tc := range cases {
+		if got := tc.p.Verify(tc.val); got != tc.want {
+			t.Errorf("%v.Verify(%v) = %v, want %v", tc.p, tc.val, got, tc.want)
+		}
+	}
+	for _, v := range []any{nil, 42, []ageRecord{{Age: 2}, {Age: 1}}} {
+		if !(SliceMonotonic{Strict: true, Increasing: true}).Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+	if s := (SliceMonotonic{Strict: true, Increasing: true}).String(); s != "SliceMonotonic(Strict=true, Increasing=true)" {
+		t.Errorf("unexpected String(): %s", s)
+	}
+}
@@ -60,6 +60,14 @@
 //
 // The Predicate interface is intentionally minimal to allow maximum flexibility
 // in defining custom validation logic.
+//
+// Built-in Predicates:
+//
+// The package also ships ready-made predicates. Each one only constrains the category
+// of values it is about (maps, slices, floats, ...): values outside that category, and
+// nil values, pass. This keeps built-in predicates composable when a function returns
+// several values of different types, since every return value is checked against every
+// predicate.
 package predicates
 
 // Predicate represents a boolean condition that can be checked against a value.
@@ -0,0 +1,153 @@
+package predicates
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ShapePredicate checks that a map with string keys, or a struct, has a required set of
+// keys (field names for structs) whose values satisfy the associated predicates.
+//
+// Fields:
+//   - Required: Maps each required key to the predicates its value must satisfy
+//     (an empty slice only checks that the key is present)
+//   - AllowExtra: If false, keys or exported fields not listed in Required are rejected
+//
+// Pointers are followed to the value they point to. Nil values and values that are not
+// maps with string keys or structs pass.
+//
+// Example usage:
+//
+//	shape := ShapePredicate{
+//	    Required: map[string][]Predicate{
+//	        "id":   {positiveID}, // any Predicate implementation
+//	        "name": {},
+//	    },
+//	    AllowExtra: true,
+//	}
+//	shape.Verify(map[string]any{"id": 1, "name": "x"}) // true
+//	shape.Verify(map[string]any{"name": "x"})          // false, "id" is missing
+type ShapePredicate struct {
+	Required   map[string][]Predicate
+	AllowExtra bool
+}
+
+func (p ShapePredicate) Verify(val any) bool {
+	fields, ok := shapeFields(reflect.ValueOf(val))
+	if !ok {
+		return true
+	}
+	for key, preds := range p.Required {
+		fieldVal, found := fields[key]
+		if !found || !satisfiesAll(fieldVal, preds) {
+			return false
+		}
+	}
+	if !p.AllowExtra {
+		for key := range fields {
+			if _, required := p.Required[key]; !required {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (p ShapePredicate) String() string {
+	keys := make([]string, 0, len(p.Required))
+	for key := range p.Required {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("ShapePredicate(required=%v, allowExtra=%v)", keys, p.AllowExtra)
+}
+
+// StructFieldRelation checks an invariant relating several fields of a struct, such as
+// Area == Width*Height or UpdatedAt >= CreatedAt, which per-field predicates cannot
+// express.
+//
+// Fields:
+//   - Fn: The relation, called with the named fields keyed by field name
+//   - Fields: The exported fields passed to Fn; when empty, every exported field is passed
+//
+// Pointers are followed to the struct they point to. Nil values and values that are not
+// structs pass. A named field that does not exist or is not exported, and a nil Fn, fail
+// because the relation cannot be checked.
+//
+// Example usage:
+//
+//	area := StructFieldRelation{
+//	    Fields: []string{"Width", "Height", "Area"},
+//	    Fn: func(f map[string]any) bool {
+//	        return f["Area"].(int) == f["Width"].(int)*f["Height"].(int)
+//	    },
+//	}
+//	area.Verify(Rectangle{Width: 2, Height: 3, Area: 6}) // true
+type StructFieldRelation struct {
+	Fn     func(fields map[string]any) bool
+	Fields []string
+}
+
+func (p StructFieldRelation) Verify(val any) bool {
+	v := reflect.ValueOf(val)
+	for v.IsValid() && v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return true
+	}
+	if p.Fn == nil {
+		return false
+	}
+	fields, _ := shapeFields(v)
+	if len(p.Fields) == 0 {
+		return p.Fn(fields)
+	}
+	named := make(map[string]any, len(p.Fields))
+	for _, name := range p.Fields {
+		fieldVal, found := fields[name]
+		if !found {
+			return false
+		}
+		named[name] = fieldVal
+	}
+	return p.Fn(named)
+}
+
+func (p StructFieldRelation) String() string { return fmt.Sprintf("StructFieldRelation(%v)", p.Fields) }
+
+// shapeFields extracts the keyed values of a string-keyed map or the exported fields of
+// a struct. It reports false for any other kind of value.
+func shapeFields(v reflect.Value) (map[string]any, bool) {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, false
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		fields := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			fields[iter.Key().String()] = iter.Value().Interface()
+		}
+		return fields, true
+	case reflect.Struct:
+		fields := make(map[string]any, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fields[v.Type().Field(i).Name] = v.Field(i).Interface()
+			}
+		}
+		return fields, true
+	}
+	return nil, false
+}
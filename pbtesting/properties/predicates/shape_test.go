@@ -0,0 +1,99 @@
+package predicates
+
+import "testing"
+
+type fixedPredicate bool
+
+func (f fixedPredicate) Verify(any) bool { return bool(f) }
+
+type shapeUser struct {
+	ID     int
+	Name   string
+	secret string
+}
+
+func TestShapePredicateMaps(t *testing.T) {
+	shape := ShapePredicate{Required: map[string][]Predicate{"id": {fixedPredicate(true)}, "name": {}}}
+	if !shape.Verify(map[string]any{"id": 1, "name": "x"}) {
+		t.Error("expected map with exactly the required keys to pass")
+	}
+	if shape.Verify(map[string]any{"name": "x"}) {
+		t.Error("expected map missing a required key to fail")
+	}
+	if shape.Verify(map[string]any{"id": 1, "name": "x", "extra": true}) {
+		t.Error("expected extra key to fail when AllowExtra is false")
+	}
+	shape.AllowExtra = true
+	if !shape.Verify(map[string]any{"id": 1, "name": "x", "extra": true}) {
+		t.Error("expected extra key to pass when AllowExtra is true")
+	}
+	shape.Required["id"] = []Predicate{fixedPredicate(false)}
+	if shape.Verify(map[string]any{"id": 1, "name": "x"}) {
+		t.Error("expected failing value predicate to fail the shape")
+	}
+}
+
+func TestShapePredicateStructs(t *testing.T) {
+	shape := ShapePredicate{Required: map[string][]Predicate{"ID": {fixedPredicate(true)}, "Name": {}}}
+	if !shape.Verify(shapeUser{ID: 1, Name: "x", secret: "ignored"}) {
+		t.Error("expected struct with the required exported fields to pass")
+	}
+	if !shape.Verify(&shapeUser{ID: 1}) {
+		t.Error("expected pointer to struct to be followed")
+	}
+	missing := ShapePredicate{Required: map[string][]Predicate{"Email": {}}, AllowExtra: true}
+	if missing.Verify(shapeUser{}) {
+		t.Error("expected struct missing a required field to fail")
+	}
+}
+
+func TestShapePredicateNotApplicable(t *testing.T) {
+	shape := ShapePredicate{Required: map[string][]Predicate{"id": {}}}
+	for _, v := range []any{nil, 42, map[int]int{1: 1}, (*shapeUser)(nil)} {
+		if !shape.Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+	if shape.String() == "" {
+		t.Error("expected a non-empty description")
+	}
+}
+
+type rectangle struct {
+	Width, Height, Area int
+	label               string
+}
+
+func TestStructFieldRelation(t *testing.T) {
+	area := StructFieldRelation{
+		Fields: []string{"Width", "Height", "Area"},
+		Fn:     func(f map[string]any) bool { return f["Area"].(int) == f["Width"].(int)*f["Height"].(int) },
+	}
+	if !area.Verify(rectangle{Width: 2, Height: 3, Area: 6}) || !area.Verify(&rectangle{Width: 1, Height: 1, Area: 1}) {
+		t.Error("expected rectangles satisfying the invariant to pass")
+	}
+	if area.Verify(rectangle{Width: 2, Height: 3, Area: 5}) {
+		t.Error("expected a rectangle breaking the invariant to fail")
+	}
+	allFields := StructFieldRelation{Fn: func(f map[string]any) bool { _, hidden := f["label"]; return len(f) == 3 && !hidden }}
+	if !allFields.Verify(rectangle{}) {
+		t.Error("expected every exported field to be passed when Fields is empty")
+	}
+	for _, p := range []StructFieldRelation{
+		{Fields: []string{"Depth"}, Fn: area.Fn},
+		{Fields: []string{"label"}, Fn: area.Fn},
+		{Fields: []string{"Width"}},
+	} {
+		if p.Verify(rectangle{}) {
+			t.Errorf("expected %v to fail when the relation cannot be checked", p)
+		}
+	}
+	for _, v := range []any{nil, (*rectangle)(nil), 42, map[string]any{"Width": 1}} {
+		if !area.Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+	if area.String() != "StructFieldRelation([Width Height Area])" {
+		t.Errorf("unexpected String(): %s", area.String())
+	}
+}
@@ -0,0 +1,40 @@
+package predicates
+
+import "fmt"
+
+// TupleIndexRelation checks a relation between two values of a result tuple, as produced
+// by PBTest.WithTuplePredicates for functions with several return values.
+//
+// Fields:
+//   - I: The index of the first value passed to Fn
+//   - J: The index of the second value passed to Fn
+//   - Fn: The relation, called as Fn(tuple[I], tuple[J])
+//
+// Values other than []any pass. A tuple that is too short for I or J, and a nil Fn, fail
+// because the relation cannot be checked.
+//
+// Example usage:
+//
+//	// func bounds(xs []int) (min, max int) must return min <= max
+//	minLEMax := TupleIndexRelation{I: 0, J: 1, Fn: func(a, b any) bool { return a.(int) <= b.(int) }}
+//	minLEMax.Verify([]any{1, 5}) // true
+type TupleIndexRelation struct {
+	I  int
+	J  int
+	Fn func(a, b any) bool
+}
+
+func (p TupleIndexRelation) Verify(val any) bool {
+	tuple, ok := val.([]any)
+	if !ok {
+		return true
+	}
+	if p.Fn == nil || p.I < 0 || p.J < 0 || p.I >= len(tuple) || p.J >= len(tuple) {
+		return false
+	}
+	return p.Fn(tuple[p.I], tuple[p.J])
+}
+
+func (p TupleIndexRelation) String() string {
+	return fmt.Sprintf("TupleIndexRelation(%d, %d)", p.I, p.J)
+}
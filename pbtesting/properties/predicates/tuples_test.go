@@ -0,0 +1,27 @@
+package predicates
+
+import "testing"
+
+func TestTupleIndexRelation(t *testing.T) {
+	p := TupleIndexRelation{I: 0, J: 2, Fn: func(a, b any) bool { return a.(int) <= b.(int) }}
+	if !p.Verify([]any{1, "ignored", 5}) || !p.Verify([]any{3, nil, 3}) {
+		t.Error("expected tuples satisfying the relation to pass")
+	}
+	if p.Verify([]any{6, "ignored", 5}) {
+		t.Error("expected a tuple violating the relation to fail")
+	}
+	if p.Verify([]any{1, 2}) || (TupleIndexRelation{I: -1, J: 0, Fn: p.Fn}).Verify([]any{1}) {
+		t.Error("expected out-of-range indices to fail")
+	}
+	if (TupleIndexRelation{I: 0, J: 0}).Verify([]any{1}) {
+		t.Error("expected a nil relation to fail")
+	}
+	for _, v := range []any{nil, 1, []int{1, 2, 3}} {
+		if !p.Verify(v) {
+			t.Errorf("expected %v to pass as not applicable", v)
+		}
+	}
+	if p.String() != "TupleIndexRelation(0, 2)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
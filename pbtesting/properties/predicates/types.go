@@ -0,0 +1,76 @@
+package predicates
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ImplementsInterface checks that a value's dynamic type implements the interface type
+// Iface, whatever its concrete type is. This suits factory functions that must return
+// something satisfying error, io.Reader and the like.
+//
+// Fields:
+//   - Iface: The interface type, usually obtained with reflect.TypeFor[io.Reader]()
+//
+// Nil values pass. Every value fails when Iface is nil or not an interface type.
+//
+// Example usage:
+//
+//	p := ImplementsInterface{Iface: reflect.TypeFor[fmt.Stringer]()}
+//	p.Verify(time.Second) // true
+//	p.Verify(42)          // false
+type ImplementsInterface struct {
+	Iface reflect.Type
+}
+
+func (p ImplementsInterface) Verify(val any) bool {
+	if p.Iface == nil || p.Iface.Kind() != reflect.Interface {
+		return false
+	}
+	if val == nil {
+		return true
+	}
+	return reflect.TypeOf(val).Implements(p.Iface)
+}
+
+func (p ImplementsInterface) String() string { return fmt.Sprintf("ImplementsInterface(%v)", p.Iface) }
+
+// IsZero checks that a value is the zero value of its type, as reported by
+// reflect.Value.IsZero: 0, "", false, a nil slice, map or pointer, a struct whose fields are
+// all zero, and so on. Unlike most built-in predicates it applies to every type. It suits
+// the contract, implicit in many Go functions, that the value returned next to a non-nil
+// error is the zero value.
+//
+// A nil value passes.
+//
+// Example usage:
+//
+//	// func parse(s string) (Config, error) must return a zero Config with an error
+//	zeroOnError := TupleIndexRelation{I: 0, J: 1, Fn: func(v, err any) bool { return err == nil || IsZero{}.Verify(v) }}
+//	IsZero{}.Verify(struct{ N int }{}) // true
+//	IsZero{}.Verify([]int{})          // false: an empty slice is not nil
+type IsZero struct{}
+
+func (p IsZero) Verify(val any) bool { return isZeroValue(val) }
+
+func (p IsZero) String() string { return "IsZero" }
+
+// IsNonZero checks that a value is not the zero value of its type, the negation of IsZero.
+//
+// A nil value fails.
+//
+// Example usage:
+//
+//	IsNonZero{}.Verify("text") // true
+//	IsNonZero{}.Verify(0.0)    // false
+type IsNonZero struct{}
+
+func (p IsNonZero) Verify(val any) bool { return !isZeroValue(val) }
+
+func (p IsNonZero) String() string { return "IsNonZero" }
+
+// isZeroValue reports whether val is nil or the zero value of its dynamic type.
+func isZeroValue(val any) bool {
+	v := reflect.ValueOf(val)
+	return !v.IsValid() || v.IsZero()
+}
@@ -0,0 +1,62 @@
+package predicates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestImplementsInterface(t *testing.T) {
+	p := ImplementsInterface{Iface: reflect.TypeFor[io.Reader]()}
+	if !p.Verify(&bytes.Buffer{}) || !p.Verify(bytes.NewReader(nil)) {
+		t.Error("expected readers to pass")
+	}
+	if p.Verify(bytes.Buffer{}) || p.Verify("text") {
+		t.Error("expected values without a Read method to fail")
+	}
+	if !p.Verify(nil) {
+		t.Error("expected nil to pass")
+	}
+	if !(ImplementsInterface{Iface: reflect.TypeFor[error]()}).Verify(errors.New("boom")) {
+		t.Error("expected an error value to implement error")
+	}
+	if !(ImplementsInterface{Iface: reflect.TypeFor[fmt.Stringer]()}).Verify(time.Second) {
+		t.Error("expected time.Duration to implement fmt.Stringer")
+	}
+	for _, iface := range []reflect.Type{nil, reflect.TypeFor[int]()} {
+		if (ImplementsInterface{Iface: iface}).Verify(1) {
+			t.Errorf("expected a non-interface Iface %v to fail", iface)
+		}
+	}
+	if p.String() != "ImplementsInterface(io.Reader)" {
+		t.Errorf("unexpected String(): %s", p.String())
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	var nilErr error
+	var nilPtr *int
+	zeros := []any{nil, nilErr, nilPtr, 0, 0.0, "", false, []int(nil), map[string]int(nil), struct{ N int }{}, [2]int{}}
+	nonZeros := []any{1, -0.5, "a", true, []int{}, map[string]int{}, struct{ N int }{N: 1}, [2]int{0, 1}, new(int), errors.New("boom")}
+	for _, v := range zeros {
+		if !(IsZero{}).Verify(v) || (IsNonZero{}).Verify(v) {
+			t.Errorf("expected %#v to be zero", v)
+		}
+	}
+	for _, v := range nonZeros {
+		if (IsZero{}).Verify(v) || !(IsNonZero{}).Verify(v) {
+			t.Errorf("expected %#v to be non-zero", v)
+		}
+	}
+	zeroOnError := TupleIndexRelation{I: 0, J: 1, Fn: func(v, err any) bool { return err == nil || IsZero{}.Verify(v) }}
+	if !zeroOnError.Verify([]any{0, errors.New("boom")}) || zeroOnError.Verify([]any{3, errors.New("boom")}) || !zeroOnError.Verify([]any{3, nil}) {
+		t.Error("expected the value returned with an error to be checked for zero")
+	}
+	if (IsZero{}).String() != "IsZero" || (IsNonZero{}).String() != "IsNonZero" {
+		t.Error("unexpected String()")
+	}
+}
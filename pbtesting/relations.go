@@ -0,0 +1,677 @@
+package pbtesting
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/laiambryant/gotestutils/ftesting"
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
+)
+
+// IdempotenceViolation records an input for which applying the function twice gave a
+// different result than applying it once.
+//
+// Fields:
+//   - Input: The generated input x
+//   - Output: The result of f(x)
+//   - Reapplied: The result of f(f(x))
+type IdempotenceViolation struct {
+	Input     any
+	Output    any
+	Reapplied any
+}
+
+// CheckIdempotentOutput checks that f(f(x)) deeply equals f(x) for randomly generated
+// inputs, which is the defining property of normalizers, formatters, deduplicators and
+// similar functions. Because the output is fed back in as an input, f must have the
+// shape func(T) T.
+//
+// Parameters:
+//   - f: The function to check, with signature func(T) T
+//   - iterations: The number of inputs to generate
+//   - a: Attributes used to generate inputs; nil uses the defaults
+//
+// Returns:
+//   - []IdempotenceViolation: One entry per input that broke idempotence (nil if none did)
+//   - error: NotEndomorphicError when f is not a func(T) T, UnsupportedParameterError when
+//     the attributes cannot generate a T, or an input generation error
+//
+// Example usage:
+//
+//	violations, err := CheckIdempotentOutput(strings.TrimSpace, 1000, nil)
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	for _, v := range violations {
+//	    t.Errorf("f(%q) = %q but f(f(x)) = %q", v.Input, v.Output, v.Reapplied)
+//	}
+func CheckIdempotentOutput(f any, iterations uint, a attributes.AttributesStruct) (violations []IdempotenceViolation, err error) {
+	fValue, err := endomorphism(f)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		a = attributes.NewFTAttributes()
+	}
+	if err := (&PBTest{f: f}).validate(a); err != nil {
+		return nil, err
+	}
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(f).WithAttributes(a)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		x := paramValue(inputs[0], fValue.Type().In(0))
+		y := fValue.Call([]reflect.Value{x})[0]
+		z := fValue.Call([]reflect.Value{y})[0]
+		if !reflect.DeepEqual(y.Interface(), z.Interface()) {
+			violations = append(violations, IdempotenceViolation{Input: x.Interface(), Output: y.Interface(), Reapplied: z.Interface()})
+		}
+	}
+	return violations, nil
+}
+
+// PermutationViolation records an input whose output was not a rearrangement of it.
+//
+// Fields:
+//   - Input: The generated input slice, as it was before f was called
+//   - Output: The slice returned by f
+//   - Missing: Elements occurring more often in Input than in Output, once per extra occurrence
+//   - Extra: Elements occurring more often in Output than in Input, once per extra occurrence
+type PermutationViolation struct {
+	Input   any
+	Output  any
+	Missing []any
+	Extra   []any
+}
+
+// CheckPermutation checks that f returns a permutation of its input, i.e. a slice holding
+// the same elements with the same multiplicities in any order. This is the defining
+// property of sorting and shuffling functions. The input is copied before f is called,
+// so functions that rearrange their argument in place are checked correctly.
+//
+// Parameters:
+//   - f: The function to check, with signature func([]T) []T
+//   - iterations: The number of inputs to generate
+//   - a: Attributes used to generate inputs; nil uses the defaults
+//
+// Returns:
+//   - []PermutationViolation: One entry per input whose output was not a permutation (nil if none)
+//   - error: NotSliceEndomorphicError when f is not a func([]T) []T, UnsupportedParameterError
+//     when the attributes cannot generate a []T, or an input generation error
+//
+// Example usage:
+//
+//	sortInts := func(s []int) []int { slices.Sort(s); return s }
+//	violations, err := CheckPermutation(sortInts, 1000, nil)
+//	for _, v := range violations {
+//	    t.Errorf("sort(%v) = %v: missing %v, extra %v", v.Input, v.Output, v.Missing, v.Extra)
+//	}
+func CheckPermutation(f any, iterations uint, a attributes.AttributesStruct) (violations []PermutationViolation, err error) {
+	fValue, err := endomorphism(f)
+	if err != nil || fValue.Type().In(0).Kind() != reflect.Slice {
+		return nil, NotSliceEndomorphicError{f}
+	}
+	if a == nil {
+		a = attributes.NewFTAttributes()
+	}
+	if err := (&PBTest{f: f}).validate(a); err != nil {
+		return nil, err
+	}
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(f).WithAttributes(a)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		x := paramValue(inputs[0], fValue.Type().In(0))
+		input := reflect.MakeSlice(x.Type(), x.Len(), x.Len())
+		reflect.Copy(input, x)
+		y := fValue.Call([]reflect.Value{x})[0]
+		if missing, extra := multisetDiff(input, y); len(missing) > 0 || len(extra) > 0 {
+			violations = append(violations, PermutationViolation{Input: input.Interface(), Output: y.Interface(), Missing: missing, Extra: extra})
+		}
+	}
+	return violations, nil
+}
+
+// LengthViolation records an input whose output length broke the expected relation.
+//
+// Fields:
+//   - Input: The generated input slice, as it was before f was called
+//   - Output: The slice returned by f
+//   - Expected: The output length the relation required
+type LengthViolation struct {
+	Input    any
+	Output   any
+	Expected int
+}
+
+// CheckLengthRelation checks that len(f(x)) equals rel(len(x)) for randomly generated
+// input slices, which captures the shape of length-transforming functions: reverse and
+// rotate preserve the length, pairing halves it, interleaving with a separator turns n
+// into 2n-1. The input is copied before f is called, so functions that modify their
+// argument in place are reported with their original input.
+//
+// Parameters:
+//   - f: The function to check, with signature func([]T) []U
+//   - rel: The expected output length for an input length; nil expects the same length
+//   - iterations: The number of inputs to generate
+//   - a: Attributes used to generate inputs; nil uses the defaults
+//
+// Returns:
+//   - []LengthViolation: One entry per input whose output had another length (nil if none)
+//   - error: NotSliceFunctionError when f is not a func([]T) []U, UnsupportedParameterError
+//     when the attributes cannot generate a []T, or an input generation error
+//
+// Example usage:
+//
+//	violations, err := CheckLengthRelation(slices.Clip[[]int], nil, 1000, nil)
+//	for _, v := range violations {
+//	    t.Errorf("f(%v) = %v, expected length %d", v.Input, v.Output, v.Expected)
+//	}
+func CheckLengthRelation(f any, rel func(inLen int) int, iterations uint, a attributes.AttributesStruct) (violations []LengthViolation, err error) {
+	fValue := reflect.ValueOf(f)
+	if !fValue.IsValid() || fValue.Kind() != reflect.Func || fValue.IsNil() {
+		return nil, NotSliceFunctionError{f}
+	}
+	fType := fValue.Type()
+	if fType.NumIn() != 1 || fType.NumOut() != 1 || fType.IsVariadic() || fType.In(0).Kind() != reflect.Slice || fType.Out(0).Kind() != reflect.Slice {
+		return nil, NotSliceFunctionError{f}
+	}
+	if rel == nil {
+		rel = func(inLen int) int { return inLen }
+	}
+	if a == nil {
+		a = attributes.NewFTAttributes()
+	}
+	if err := (&PBTest{f: f}).validate(a); err != nil {
+		return nil, err
+	}
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(f).WithAttributes(a)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		x := paramValue(inputs[0], fType.In(0))
+		input := reflect.MakeSlice(x.Type(), x.Len(), x.Len())
+		reflect.Copy(input, x)
+		y := fValue.Call([]reflect.Value{x})[0]
+		if expected := rel(input.Len()); y.Len() != expected {
+			violations = append(violations, LengthViolation{Input: input.Interface(), Output: y.Interface(), Expected: expected})
+		}
+	}
+	return violations, nil
+}
+
+// MapInverseViolation records a map that did not survive being inverted twice.
+//
+// Fields:
+//   - Input: The generated injective map, as it was before invert was called
+//   - Inverted: The map returned by invert
+//   - RoundTrip: The map obtained by inverting Inverted back
+type MapInverseViolation struct {
+	Input     any
+	Inverted  any
+	RoundTrip any
+}
+
+// CheckMapInverseRoundTrip checks that inverting a map twice yields the original map,
+// which is the defining property of functions building reverse indexes. The input maps
+// are generated with distinct values (MapAttributes.UniqueValues), so that every one of
+// them has an inverse. Since Go cannot pass an uninstantiated generic function, the way
+// back is a separate function, usually another instantiation of the same one; when it is
+// nil, the inverted map is swapped back with a reference inversion, which checks that
+// invert maps every value to its key and nothing else.
+//
+// Type parameters:
+//   - K: The key type of the input maps
+//   - V: The value type of the input maps
+//
+// Parameters:
+//   - invert: The inversion to check
+//   - invertBack: The inversion applied to the result of invert; nil uses a reference one
+//   - iterations: The number of maps to generate
+//   - a: Attributes used to generate the maps; nil uses the defaults. When a is an
+//     FTAttributes, its MapAttr gets UniqueValues and, unless it already generates
+//     map[K]V, the key and value attributes configured for K and V
+//
+// Returns:
+//   - []MapInverseViolation: One entry per map that did not round-trip (nil if none)
+//   - error: FunctionNotProvidedError when invert is nil, UnsupportedParameterError when the
+//     attributes cannot generate a map[K]V, or an input generation error
+//
+// Example usage:
+//
+//	violations, err := CheckMapInverseRoundTrip(Invert[string, int], Invert[int, string], 1000, nil)
+//	for _, v := range violations {
+//	    t.Errorf("invert(%v) = %v, inverted back to %v", v.Input, v.Inverted, v.RoundTrip)
+//	}
+func CheckMapInverseRoundTrip[K, V comparable](
+	invert func(map[K]V) map[V]K,
+	invertBack func(map[V]K) map[K]V,
+	iterations uint,
+	a attributes.AttributesStruct,
+) (violations []MapInverseViolation, err error) {
+	if invert == nil {
+		return nil, FunctionNotProvidedError{}
+	}
+	if invertBack == nil {
+		invertBack = func(m map[V]K) map[K]V {
+			back := make(map[K]V, len(m))
+			for v, k := range m {
+				back[k] = v
+			}
+			return back
+		}
+	}
+	a, err = injectiveMaps[K, V](a)
+	if err != nil {
+		return nil, err
+	}
+	if err := (&PBTest{f: invert}).validate(a); err != nil {
+		return nil, err
+	}
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(invert).WithAttributes(a)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		input := paramValue(inputs[0], reflect.TypeFor[map[K]V]()).Interface().(map[K]V)
+		inverted := invert(maps.Clone(input))
+		roundTrip := invertBack(maps.Clone(inverted))
+		if !maps.Equal(input, roundTrip) {
+			violations = append(violations, MapInverseViolation{Input: input, Inverted: inverted, RoundTrip: roundTrip})
+		}
+	}
+	return violations, nil
+}
+
+// injectiveMaps returns attributes generating map[K]V values with distinct values. Only
+// an FTAttributes (the defaults when attrs is nil) can be adjusted; other attributes are
+// returned unchanged, and the caller validates that they generate map[K]V. It returns an
+// UnsupportedParameterError when no attributes are configured for K or V.
+func injectiveMaps[K, V comparable](attrs attributes.AttributesStruct) (attributes.AttributesStruct, error) {
+	if attrs == nil {
+		attrs = attributes.NewFTAttributes()
+	}
+	ftAttrs, ok := attrs.(attributes.FTAttributes)
+	if !ok {
+		return attrs, nil
+	}
+	mapType := reflect.TypeFor[map[K]V]()
+	mapAttr := ftAttrs.MapAttr
+	if mapAttr.GetReflectType() != mapType {
+		keyAttrs, err := ftAttrs.GetAttributeGivenType(mapType.Key())
+		if err != nil {
+			return nil, UnsupportedParameterError{Index: 0, Type: mapType, Reason: "no key can be generated: " + err.Error(), Err: err}
+		}
+		valueAttrs, err := ftAttrs.GetAttributeGivenType(mapType.Elem())
+		if err != nil {
+			return nil, UnsupportedParameterError{Index: 0, Type: mapType, Reason: "no value can be generated: " + err.Error(), Err: err}
+		}
+		mapAttr.KeyAttrs, mapAttr.ValueAttrs = keyAttrs, valueAttrs
+		mapAttr.KeyPreds, mapAttr.ValuePreds = nil, nil
+	}
+	mapAttr.UniqueValues = true
+	ftAttrs.MapAttr = mapAttr
+	return ftAttrs, nil
+}
+
+// MergeViolation records two sorted inputs whose merge was not a sorted rearrangement of
+// their elements.
+//
+// Fields:
+//   - Left: The first generated sorted slice, as it was before merge was called
+//   - Right: The second generated sorted slice, as it was before merge was called
+//   - Output: The slice returned by merge
+//   - Unsorted: Whether Output is not in non-decreasing order
+//   - Missing: Elements occurring more often in Left and Right than in Output, once per
+//     extra occurrence
+//   - Extra: Elements occurring more often in Output than in Left and Right, once per
+//     extra occurrence
+type MergeViolation struct {
+	Left     []int
+	Right    []int
+	Output   []int
+	Unsorted bool
+	Missing  []any
+	Extra    []any
+}
+
+// CheckMergeSorted checks that merge combines two sorted slices into one sorted slice
+// holding the elements of both with the same multiplicities, which is the defining
+// property of the merge step of merge sort and of sorted-set unions that keep
+// duplicates. The inputs are generated sorted (SliceAttributes.Sorted) and copied before
+// merge is called, so merges working in place are checked correctly.
+//
+// Parameters:
+//   - merge: The merge to check
+//   - iterations: The number of input pairs to generate
+//   - a: Attributes used to generate the slices; nil uses the defaults. When a is an
+//     FTAttributes, its SliceAttr gets Sorted and draws the elements from the attributes
+//     configured for int, so that their range is set with IntegerAttr; slices generated by
+//     other attributes are sorted before merge is called
+//
+// Returns:
+//   - []MergeViolation: One entry per input pair whose merge was wrong (nil if none)
+//   - error: FunctionNotProvidedError when merge is nil, UnsupportedParameterError when the
+//     attributes cannot generate a []int, or an input generation error
+//
+// Example usage:
+//
+//	violations, err := CheckMergeSorted(mergeInts, 1000, nil)
+//	for _, v := range violations {
+//	    t.Errorf("merge(%v, %v) = %v: unsorted %v, missing %v, extra %v", v.Left, v.Right, v.Output, v.Unsorted, v.Missing, v.Extra)
+//	}
+func CheckMergeSorted(merge func([]int, []int) []int, iterations uint, a attributes.AttributesStruct) (violations []MergeViolation, err error) {
+	if merge == nil {
+		return nil, FunctionNotProvidedError{}
+	}
+	a, err = sortedIntSlices(a)
+	if err != nil {
+		return nil, err
+	}
+	if err := (&PBTest{f: merge}).validate(a); err != nil {
+		return nil, err
+	}
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(merge).WithAttributes(a)
+	intsType := reflect.TypeFor[[]int]()
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		left := paramValue(inputs[0], intsType).Interface().([]int)
+		right := paramValue(inputs[1], intsType).Interface().([]int)
+		left, right = slices.Sorted(slices.Values(left)), slices.Sorted(slices.Values(right))
+		output := merge(slices.Clone(left), slices.Clone(right))
+		missing, extra := multisetDiff(reflect.ValueOf(slices.Concat(left, right)), reflect.ValueOf(output))
+		if unsorted := !slices.IsSorted(output); unsorted || len(missing) > 0 || len(extra) > 0 {
+			violations = append(violations, MergeViolation{Left: left, Right: right, Output: output, Unsorted: unsorted, Missing: missing, Extra: extra})
+		}
+	}
+	return violations, nil
+}
+
+// sortedIntSlices returns attributes generating sorted []int values whose elements come
+// from the attributes configured for int. Only an FTAttributes (the defaults when attrs
+// is nil) can be adjusted; other attributes are returned unchanged, and the caller
+// validates that they generate []int. It returns an UnsupportedParameterError when the
+// attributes configured for int are invalid.
+func sortedIntSlices(attrs attributes.AttributesStruct) (attributes.AttributesStruct, error) {
+	if attrs == nil {
+		attrs = attributes.NewFTAttributes()
+	}
+	ftAttrs, ok := attrs.(attributes.FTAttributes)
+	if !ok {
+		return attrs, nil
+	}
+	elemAttrs, err := ftAttrs.GetAttributeGivenType(reflect.TypeFor[int]())
+	if err != nil {
+		return nil, UnsupportedParameterError{Index: 0, Type: reflect.TypeFor[[]int](), Reason: "no element can be generated: " + err.Error(), Err: err}
+	}
+	sliceAttr := ftAttrs.SliceAttr
+	sliceAttr.ElementAttrs, sliceAttr.ElementPreds = elemAttrs, nil
+	sliceAttr.Sorted, sliceAttr.Less = true, nil
+	ftAttrs.SliceAttr = sliceAttr
+	return ftAttrs, nil
+}
+
+// JSONRoundTripViolation records a value that did not survive being marshaled to JSON
+// and unmarshaled back.
+//
+// Fields:
+//   - Input: The generated value
+//   - Encoded: The JSON encoding of Input ("" when marshaling failed)
+//   - RoundTrip: The value unmarshaled from Encoded (nil when marshaling or unmarshaling
+//     failed)
+//   - Err: The error of json.Marshal or json.Unmarshal, if any
+//   - Note: The common JSON gotchas that apply to the type of Input, such as unexported
+//     struct fields, or "" when none does
+type JSONRoundTripViolation struct {
+	Input     any
+	Encoded   string
+	RoundTrip any
+	Err       error
+	Note      string
+}
+
+// CheckJSONRoundTrip checks that json.Unmarshal(json.Marshal(x)) deeply equals x for
+// randomly generated values of type T, which is the defining property of types that are
+// serialized with encoding/json. Every value is unmarshaled into a fresh T.
+//
+// Type parameters:
+//   - T: The type of the values to round-trip
+//
+// Parameters:
+//   - iterations: The number of values to generate
+//   - a: Attributes used to generate the values; nil uses the defaults
+//
+// Returns:
+//   - []JSONRoundTripViolation: One entry per value that did not round-trip (nil if none);
+//     Note lists the gotchas of T, such as unexported fields that are not encoded or
+//     numbers stored in interfaces that come back as float64
+//   - error: UnsupportedParameterError when the attributes cannot generate a T, or an
+//     input generation error
+//
+// Example usage:
+//
+//	violations, err := CheckJSONRoundTrip[Order](1000, attrs)
+//	for _, v := range violations {
+//	    t.Errorf("%+v encoded as %s came back as %+v (%v) %s", v.Input, v.Encoded, v.RoundTrip, v.Err, v.Note)
+//	}
+func CheckJSONRoundTrip[T any](iterations uint, a attributes.AttributesStruct) (violations []JSONRoundTripViolation, err error) {
+	if a == nil {
+		a = attributes.NewFTAttributes()
+	}
+	f := func(T) {}
+	if err := (&PBTest{f: f}).validate(a); err != nil {
+		return nil, err
+	}
+	t := reflect.TypeFor[T]()
+	note := jsonGotchas(t)
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(f).WithAttributes(a)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		input := paramValue(inputs[0], t)
+		violation := JSONRoundTripViolation{Input: input.Interface(), Note: note}
+		encoded, err := json.Marshal(violation.Input)
+		if err == nil {
+			violation.Encoded = string(encoded)
+			back := new(T)
+			if err = json.Unmarshal(encoded, back); err == nil {
+				violation.RoundTrip = *back
+			}
+		}
+		violation.Err = err
+		if err != nil || !reflect.DeepEqual(violation.Input, violation.RoundTrip) {
+			violations = append(violations, violation)
+		}
+	}
+	return violations, nil
+}
+
+// OverflowViolation records inputs for which the function's result, widened to int64,
+// differed from the reference computation, i.e. for which it silently wrapped around.
+//
+// Fields:
+//   - A: The first generated argument
+//   - B: The second generated argument
+//   - Got: The result of f(A, B) widened to int64
+//   - Want: The result of ref(int64(A), int64(B))
+type OverflowViolation struct {
+	A    int
+	B    int
+	Got  int64
+	Want int64
+}
+
+// CheckNoOverflow checks that int64(f(a, b)) equals ref(int64(a), int64(b)) for randomly
+// generated integers, so that integer arithmetic in f never wraps around. Output-range
+// predicates miss such bugs because a wrapped value may still lie in range. This catches
+// overflow of int on 32-bit platforms and of narrower intermediate types such as int32 or
+// uint16; ref must itself not overflow int64 for the generated inputs, so bound them with
+// the attributes when the computation grows fast.
+//
+// Parameters:
+//   - f: The function to check
+//   - ref: The same computation carried out in int64
+//   - iterations: The number of input pairs to generate
+//   - a: Attributes used to generate inputs; nil uses the defaults
+//
+// Returns:
+//   - []OverflowViolation: One entry per input pair whose results differed (nil if none did)
+//   - error: FunctionNotProvidedError when f or ref is nil, UnsupportedParameterError when
+//     the attributes cannot generate an int, or an input generation error
+//
+// Example usage:
+//
+//	area := func(w, h int) int { return int(int32(w) * int32(h)) }
+//	violations, err := CheckNoOverflow(area, func(w, h int64) int64 { return w * h }, 1000,
+//	    attributes.NewFTAttributes(attributes.WithIntegerRange(0, 1_000_000)))
+//	for _, v := range violations {
+//	    t.Errorf("area(%d, %d) = %d, want %d", v.A, v.B, v.Got, v.Want)
+//	}
+func CheckNoOverflow(f func(int, int) int, ref func(int64, int64) int64, iterations uint, a attributes.AttributesStruct) (violations []OverflowViolation, err error) {
+	if f == nil || ref == nil {
+		return nil, FunctionNotProvidedError{}
+	}
+	if a == nil {
+		a = attributes.NewFTAttributes()
+	}
+	if err := (&PBTest{f: f}).validate(a); err != nil {
+		return nil, err
+	}
+	fuzzTest := (&ftesting.FTesting{}).WithFunction(f).WithAttributes(a)
+	for i := uint(0); i < iterations; i++ {
+		inputs, err := fuzzTest.GenerateInputs()
+		if err != nil {
+			return nil, err
+		}
+		x := int(paramValue(inputs[0], reflect.TypeFor[int]()).Int())
+		y := int(paramValue(inputs[1], reflect.TypeFor[int]()).Int())
+		if got, want := int64(f(x, y)), ref(int64(x), int64(y)); got != want {
+			violations = append(violations, OverflowViolation{A: x, B: y, Got: got, Want: want})
+		}
+	}
+	return violations, nil
+}
+
+// jsonGotchas describes the parts of t that encoding/json does not round-trip: unexported
+// struct fields are skipped, values stored in interfaces come back as float64, string,
+// bool, []any or map[string]any, and function and channel values cannot be marshaled.
+func jsonGotchas(t reflect.Type) string {
+	found := map[string]bool{}
+	var walk func(t reflect.Type, seen map[reflect.Type]bool)
+	walk = func(t reflect.Type, seen map[reflect.Type]bool) {
+		if seen[t] {
+			return
+		}
+		seen[t] = true
+		switch t.Kind() {
+		case reflect.Interface:
+			found["values stored in interfaces come back as float64, string, bool, []any or map[string]any"] = true
+		case reflect.Func, reflect.Chan, reflect.Complex64, reflect.Complex128:
+			found["functions, channels and complex numbers cannot be marshaled"] = true
+		case reflect.Pointer, reflect.Slice, reflect.Array:
+			walk(t.Elem(), seen)
+		case reflect.Map:
+			walk(t.Key(), seen)
+			walk(t.Elem(), seen)
+		case reflect.Struct:
+			for i := 0; i < t.NumField(); i++ {
+				field := t.Field(i)
+				if !field.IsExported() && !field.Anonymous {
+					found["unexported struct fields are not encoded"] = true
+					continue
+				}
+				if field.Tag.Get("json") == "-" {
+					found["fields tagged json:\"-\" are not encoded"] = true
+					continue
+				}
+				walk(field.Type, seen)
+			}
+		}
+	}
+	walk(t, map[reflect.Type]bool{})
+	return strings.Join(slices.Sorted(maps.Keys(found)), "; ")
+}
+
+// multisetDiff compares the elements of two slices as multisets. Comparable elements are
+// counted in a map; anything else falls back to a reflect.DeepEqual scan.
+func multisetDiff(in, out reflect.Value) (missing, extra []any) {
+	elemType := in.Type().Elem()
+	if elemType.Comparable() && elemType.Kind() != reflect.Interface {
+		counts := map[any]int{}
+		for i := 0; i < in.Len(); i++ {
+			counts[in.Index(i).Interface()]++
+		}
+		for i := 0; i < out.Len(); i++ {
+			elem := out.Index(i).Interface()
+			if counts[elem] == 0 {
+				extra = append(extra, elem)
+				continue
+			}
+			counts[elem]--
+		}
+		for i := 0; i < in.Len(); i++ {
+			elem := in.Index(i).Interface()
+			if counts[elem] > 0 {
+				missing = append(missing, elem)
+				counts[elem]--
+			}
+		}
+		return missing, extra
+	}
+	remaining := make([]any, in.Len())
+	for i := range remaining {
+		remaining[i] = in.Index(i).Interface()
+	}
+	for i := 0; i < out.Len(); i++ {
+		elem := out.Index(i).Interface()
+		j := slices.IndexFunc(remaining, func(r any) bool { return reflect.DeepEqual(r, elem) })
+		if j < 0 {
+			extra = append(extra, elem)
+			continue
+		}
+		remaining = slices.Delete(remaining, j, j+1)
+	}
+	if len(remaining) > 0 {
+		missing = remaining
+	}
+	return missing, extra
+}
+
+// paramValue returns a generated input as a value of the parameter type t: values of
+// another type are converted, which validate guarantees to be possible, and nil becomes
+// the zero value of t.
+func paramValue(input any, t reflect.Type) reflect.Value {
+	v := reflect.ValueOf(input)
+	if !v.IsValid() {
+		return reflect.Zero(t)
+	}
+	return v.Convert(t)
+}
+
+// endomorphism validates that f is a func(T) T and returns it as a reflect.Value.
+func endomorphism(f any) (reflect.Value, error) {
+	fValue := reflect.ValueOf(f)
+	if !fValue.IsValid() || fValue.Kind() != reflect.Func || fValue.IsNil() {
+		return reflect.Value{}, NotEndomorphicError{f}
+	}
+	fType := fValue.Type()
+	if fType.NumIn() != 1 || fType.NumOut() != 1 || fType.IsVariadic() || fType.In(0) != fType.Out(0) {
+		return reflect.Value{}, NotEndomorphicError{f}
+	}
+	return fValue, nil
+}
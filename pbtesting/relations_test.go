@@ -0,0 +1,399 @@
+package pbtesting
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
+)
+
+func TestCheckIdempotentOutput(t *testing.T) {
+	violations, err := CheckIdempotentOutput(strings.TrimSpace, 50, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("expected TrimSpace to be idempotent, got %v", violations)
+	}
+}
+
+func TestCheckIdempotentOutput_Violations(t *testing.T) {
+	increment := func(n int) int { return n + 1 }
+	attrs := attributes.NewFTAttributes()
+	attrs.IntegerAttr = attributes.IntegerAttributesImpl[int]{Min: 1, Max: 10}
+	violations, err := CheckIdempotentOutput(increment, 10, attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 10 {
+		t.Fatalf("expected every input to violate idempotence, got %d", len(violations))
+	}
+	v := violations[0]
+	if v.Output != v.Input.(int)+1 || v.Reapplied != v.Input.(int)+2 {
+		t.Errorf("expected (x, x+1, x+2), got %+v", v)
+	}
+}
+
+func TestCheckIdempotentOutput_ParameterTypes(t *testing.T) {
+	abs := func(n int8) int8 {
+		if n < 0 && n != -128 {
+			return -n
+		}
+		return n
+	}
+	violations, err := CheckIdempotentOutput(abs, 20, nil)
+	if err != nil || len(violations) != 0 {
+		t.Errorf("expected generated ints to be converted to int8, got %v (err %v)", violations, err)
+	}
+	var upe UnsupportedParameterError
+	if _, err := CheckIdempotentOutput(slices.Clip[[]string], 20, nil); !errors.As(err, &upe) {
+		t.Errorf("expected UnsupportedParameterError for []string inputs, got %v", err)
+	}
+}
+
+func TestCheckIdempotentOutput_NotEndomorphic(t *testing.T) {
+	for _, f := range []any{nil, 42, func(int) string { return "" }, func(a, b int) int { return a }, func(int) {}, func(...int) []int { return nil }} {
+		_, err := CheckIdempotentOutput(f, 10, nil)
+		if _, ok := err.(NotEndomorphicError); !ok {
+			t.Errorf("expected NotEndomorphicError for %T, got %v", f, err)
+		}
+	}
+}
+
+func TestCheckPermutation(t *testing.T) {
+	sortInPlace := func(s []int) []int { slices.Sort(s); return s }
+	violations, err := CheckPermutation(sortInPlace, 50, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("expected sorting to be a permutation, got %v", violations)
+	}
+}
+
+func TestCheckPermutation_ParameterTypes(t *testing.T) {
+	sortInPlace := func(s []int8) []int8 { slices.Sort(s); return s }
+	var upe UnsupportedParameterError
+	if _, err := CheckPermutation(sortInPlace, 20, nil); !errors.As(err, &upe) {
+		t.Errorf("expected UnsupportedParameterError for []int8 inputs, got %v", err)
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.SliceAttr = attributes.SliceAttributes{MinLen: 1, MaxLen: 5, ElementAttrs: attributes.IntegerAttributesImpl[int8]{Min: -5, Max: 5}}
+	violations, err := CheckPermutation(sortInPlace, 20, attrs)
+	if err != nil || len(violations) != 0 {
+		t.Errorf("expected []int8 inputs to be generated, got %v (err %v)", violations, err)
+	}
+}
+
+func TestCheckPermutation_Violations(t *testing.T) {
+	dropFirst := func(s []int) []int { return append(s[1:], 1000) }
+	attrs := attributes.NewFTAttributes()
+	attrs.SliceAttr = attributes.SliceAttributes{MinLen: 3, MaxLen: 3, ElementAttrs: attributes.IntegerAttributesImpl[int]{Min: 1, Max: 10}}
+	violations, err := CheckPermutation(dropFirst, 10, attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 10 {
+		t.Fatalf("expected every input to be reported, got %d", len(violations))
+	}
+	v := violations[0]
+	if !reflect.DeepEqual(v.Missing, []any{v.Input.([]int)[0]}) || !reflect.DeepEqual(v.Extra, []any{1000}) {
+		t.Errorf("expected the first input element missing and 1000 extra, got %+v", v)
+	}
+}
+
+func TestMultisetDiff_NonComparable(t *testing.T) {
+	in := reflect.ValueOf([][]int{{1}, {2}, {2}})
+	out := reflect.ValueOf([][]int{{2}, {3}, {1}})
+	missing, extra := multisetDiff(in, out)
+	if !reflect.DeepEqual(missing, []any{[]int{2}}) || !reflect.DeepEqual(extra, []any{[]int{3}}) {
+		t.Errorf("expected missing [[2]] and extra [[3]], got %v and %v", missing, extra)
+	}
+}
+
+func TestCheckPermutation_NotSliceEndomorphic(t *testing.T) {
+	for _, f := range []any{nil, func(n int) int { return n }, func([]int) []string { return nil }} {
+		_, err := CheckPermutation(f, 10, nil)
+		if _, ok := err.(NotSliceEndomorphicError); !ok {
+			t.Errorf("expected NotSliceEndomorphicError for %T, got %v", f, err)
+		}
+	}
+}
+
+func TestCheckLengthRelation(t *testing.T) {
+	reverseInPlace := func(s []int) []int { slices.Reverse(s); return s }
+	violations, err := CheckLengthRelation(reverseInPlace, nil, 50, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("expected reverse to preserve the length, got %v", violations)
+	}
+	interleave := func(s []int) []string {
+		var out []string
+		for i, n := range s {
+			if i > 0 {
+				out = append(out, ",")
+			}
+			out = append(out, strconv.Itoa(n))
+		}
+		return out
+	}
+	if violations, _ := CheckLengthRelation(interleave, func(n int) int { return max(2*n-1, 0) }, 50, nil); len(violations) != 0 {
+		t.Errorf("expected interleaving to produce 2n-1 elements, got %v", violations)
+	}
+}
+
+func TestCheckLengthRelation_Violations(t *testing.T) {
+	dropLastInPlace := func(s []int) []int {
+		out := s[:len(s)-1]
+		for i := range out {
+			s[i] = 0
+		}
+		return out
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.SliceAttr = attributes.SliceAttributes{MinLen: 3, MaxLen: 3, ElementAttrs: attributes.IntegerAttributesImpl[int]{Min: 1, Max: 10}}
+	violations, err := CheckLengthRelation(dropLastInPlace, nil, 10, attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 10 {
+		t.Fatalf("expected every input to be reported, got %d", len(violations))
+	}
+	v := violations[0]
+	if v.Expected != 3 || len(v.Output.([]int)) != 2 || slices.Contains(v.Input.([]int), 0) {
+		t.Errorf("expected the original input, a 2-element output and expected length 3, got %+v", v)
+	}
+}
+
+func TestCheckLengthRelation_ParameterTypes(t *testing.T) {
+	var upe UnsupportedParameterError
+	if _, err := CheckLengthRelation(slices.Clip[[]string], nil, 20, nil); !errors.As(err, &upe) {
+		t.Errorf("expected UnsupportedParameterError for []string inputs, got %v", err)
+	}
+	type ints []int
+	violations, err := CheckLengthRelation(slices.Clip[ints], nil, 20, nil)
+	if err != nil || len(violations) != 0 {
+		t.Errorf("expected generated []int values to be converted to a named slice type, got %v (err %v)", violations, err)
+	}
+}
+
+func TestCheckLengthRelation_NotSliceFunction(t *testing.T) {
+	for _, f := range []any{nil, 42, strings.ToUpper, func([]int) int { return 0 }, func(...int) []int { return nil }, func(a, b []int) []int { return a }} {
+		_, err := CheckLengthRelation(f, nil, 10, nil)
+		if _, ok := err.(NotSliceFunctionError); !ok {
+			t.Errorf("expected NotSliceFunctionError for %T, got %v", f, err)
+		}
+	}
+}
+
+func invertMap[K, V comparable](m map[K]V) map[V]K {
+	out := make(map[V]K, len(m))
+	for k, v := range m {
+		out[v] = k
+	}
+	return out
+}
+
+func TestCheckMapInverseRoundTrip(t *testing.T) {
+	violations, err := CheckMapInverseRoundTrip(invertMap[string, int], invertMap[int, string], 50, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("expected inverting twice to give back the map, got %v", violations)
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.IntegerAttr = attributes.IntegerAttributesImpl[int]{Min: 0, Max: 1000}
+	if violations, err := CheckMapInverseRoundTrip(invertMap[int, float64], nil, 50, attrs); err != nil || len(violations) != 0 {
+		t.Errorf("expected map[int]float64 values to be generated and inverted, got %v and %v", violations, err)
+	}
+	attrs.MapAttr.MinSize, attrs.MapAttr.MaxSize = 3, 5
+	attrs.MapAttr.ValueAttrs = attributes.IntegerAttributesImpl[int]{Min: 0, Max: 3}
+	if violations, _ := CheckMapInverseRoundTrip(invertMap[string, int], nil, 50, attrs); len(violations) != 0 {
+		t.Errorf("expected maps with distinct values from a small domain, got %v", violations)
+	}
+}
+
+func TestCheckMapInverseRoundTrip_Violations(t *testing.T) {
+	dropOne := func(m map[string]int) map[int]string {
+		out := invertMap(m)
+		for v := range out {
+			delete(out, v)
+			break
+		}
+		return out
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.MapAttr.MinSize, attrs.MapAttr.MaxSize = 2, 4
+	violations, err := CheckMapInverseRoundTrip(dropOne, nil, 10, attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 10 {
+		t.Fatalf("expected every map to be reported, got %d", len(violations))
+	}
+	v := violations[0]
+	if len(v.Input.(map[string]int)) != len(v.Inverted.(map[int]string))+1 || len(v.RoundTrip.(map[string]int)) != len(v.Inverted.(map[int]string)) {
+		t.Errorf("expected the input, the inversion missing one entry and its round trip, got %+v", v)
+	}
+	if _, err := CheckMapInverseRoundTrip[int, int](nil, nil, 10, nil); err != (FunctionNotProvidedError{}) {
+		t.Errorf("expected FunctionNotProvidedError for a nil inversion, got %v", err)
+	}
+}
+
+func TestCheckMapInverseRoundTrip_Unsupported(t *testing.T) {
+	alwaysNil := func(map[int8]string) map[string]int8 { return nil }
+	var upe UnsupportedParameterError
+	if _, err := CheckMapInverseRoundTrip(alwaysNil, nil, 10, nil); !errors.As(err, &upe) || upe.Type != reflect.TypeFor[map[int8]string]() {
+		t.Errorf("expected UnsupportedParameterError for map[int8]string inputs, got %v", err)
+	}
+	noKeys := func(map[chan int]int) map[int]chan int { return nil }
+	if _, err := CheckMapInverseRoundTrip(noKeys, nil, 10, nil); !errors.As(err, &upe) || upe.Err == nil {
+		t.Errorf("expected UnsupportedParameterError for channel keys, got %v", err)
+	}
+}
+
+type jsonPayload struct {
+	ID     int
+	Extra  any
+	hidden int
+}
+
+func TestCheckJSONRoundTrip(t *testing.T) {
+	if violations, err := CheckJSONRoundTrip[map[string]int](50, nil); err != nil || len(violations) != 0 {
+		t.Errorf("expected maps to round-trip, got %v and %v", violations, err)
+	}
+	if violations, err := CheckJSONRoundTrip[int8](50, nil); err != nil || len(violations) != 0 {
+		t.Errorf("expected generated ints to be converted and round-trip, got %v and %v", violations, err)
+	}
+	attrs := attributes.NewFTAttributes()
+	attrs.StructAttr = attributes.StructAttributes{
+		TargetType: reflect.TypeFor[jsonPayload](),
+		FieldAttrs: map[string]any{"ID": attributes.IntegerAttributesImpl[int]{Min: 1, Max: 9}, "Extra": attributes.IntegerAttributesImpl[int]{Min: 1, Max: 9}},
+	}
+	violations, err := CheckJSONRoundTrip[jsonPayload](5, attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 5 {
+		t.Fatalf("expected every payload to be reported, got %d", len(violations))
+	}
+	v := violations[0]
+	in, back := v.Input.(jsonPayload), v.RoundTrip.(jsonPayload)
+	if _, ok := back.Extra.(float64); !ok || in.ID != back.ID || v.Err != nil || !strings.HasPrefix(v.Encoded, `{"ID":`) {
+		t.Errorf("expected the int in Extra to come back as a float64, got %+v", v)
+	}
+	if v.Note != "unexported struct fields are not encoded; values stored in interfaces come back as float64, string, bool, []any or map[string]any" {
+		t.Errorf("unexpected note %q", v.Note)
+	}
+}
+
+type infAttributes struct{}
+
+func (infAttributes) GetAttributes() any                              { return infAttributes{} }
+func (infAttributes) GetReflectType() reflect.Type                    { return reflect.TypeFor[float64]() }
+func (infAttributes) GetDefaultImplementation() attributes.Attributes { return infAttributes{} }
+func (infAttributes) GetRandomValue() any                             { return math.Inf(1) }
+
+func TestCheckJSONRoundTrip_Errors(t *testing.T) {
+	attrs := attributes.NewFTAttributes()
+	attrs.TypeAttrs = map[reflect.Type]attributes.Attributes{reflect.TypeFor[float64](): infAttributes{}}
+	violations, err := CheckJSONRoundTrip[float64](3, attrs)
+	if err != nil || len(violations) != 3 || violations[0].Err == nil || violations[0].Encoded != "" {
+		t.Errorf("expected marshaling +Inf to be reported, got %v and %v", violations, err)
+	}
+	var upe UnsupportedParameterError
+	if _, err := CheckJSONRoundTrip[chan int](3, nil); !errors.As(err, &upe) {
+		t.Errorf("expected UnsupportedParameterError for a channel, got %v", err)
+	}
+	if got := jsonGotchas(reflect.TypeFor[struct {
+		F func()
+		S string `json:"-"`
+	}]()); got != `fields tagged json:"-" are not encoded; functions, channels and complex numbers cannot be marshaled` {
+		t.Errorf("unexpected note %q", got)
+	}
+}
+
+func TestCheckNoOverflow(t *testing.T) {
+	small := attributes.NewFTAttributes(attributes.WithIntegerRange(100_000, 1_000_000))
+	product := func(a, b int64) int64 { return a * b }
+	violations, err := CheckNoOverflow(func(a, b int) int { return a * b }, product, 100, small)
+	if err != nil || violations != nil {
+		t.Fatalf("expected no overflow, got %v (err %v)", violations, err)
+	}
+	narrow := func(a, b int) int { return int(int32(a) * int32(b)) }
+	violations, err = CheckNoOverflow(narrow, product, 100, small)
+	if err != nil || len(violations) != 100 {
+		t.Fatalf("expected every product to overflow int32, got %d violations (err %v)", len(violations), err)
+	}
+	v := violations[0]
+	if v.Got != int64(narrow(v.A, v.B)) || v.Want != int64(v.A)*int64(v.B) {
+		t.Errorf("unexpected violation %+v", v)
+	}
+	if _, err := CheckNoOverflow(nil, product, 1, nil); !errors.As(err, new(FunctionNotProvidedError)) {
+		t.Errorf("expected FunctionNotProvidedError, got %v", err)
+	}
+}
+
+func mergeInts(a, b []int) []int {
+	merged := make([]int, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if a[0] <= b[0] {
+			merged, a = append(merged, a[0]), a[1:]
+		} else {
+			merged, b = append(merged, b[0]), b[1:]
+		}
+	}
+	return append(append(merged, a...), b...)
+}
+
+func TestCheckMergeSorted(t *testing.T) {
+	attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(0, 5))
+	attrs.SliceAttr.MinLen, attrs.SliceAttr.MaxLen = 1, 8
+	violations, err := CheckMergeSorted(mergeInts, 200, attrs)
+	if err != nil || violations != nil {
+		t.Fatalf("expected a correct merge to pass, got %v (err %v)", violations, err)
+	}
+	violations, err = CheckMergeSorted(func(a, b []int) []int { return append(a, b...) }, 200, attrs)
+	if err != nil || len(violations) == 0 {
+		t.Fatalf("expected concatenation to be reported, got %v (err %v)", violations, err)
+	}
+	for _, v := range violations {
+		if !v.Unsorted || v.Missing != nil || v.Extra != nil || !slices.IsSorted(v.Left) || !slices.IsSorted(v.Right) {
+			t.Errorf("expected an unsorted output of sorted inputs with the same elements, got %+v", v)
+		}
+	}
+	dedup := func(a, b []int) []int { return slices.Compact(mergeInts(a, b)) }
+	violations, err = CheckMergeSorted(dedup, 200, attrs)
+	if err != nil || len(violations) == 0 {
+		t.Fatalf("expected dropped duplicates to be reported, got %v (err %v)", violations, err)
+	}
+	if v := violations[0]; v.Unsorted || len(v.Missing) == 0 || v.Extra != nil {
+		t.Errorf("expected missing elements only, got %+v", v)
+	}
+	if _, err := CheckMergeSorted(nil, 1, nil); !errors.As(err, new(FunctionNotProvidedError)) {
+		t.Errorf("expected FunctionNotProvidedError, got %v", err)
+	}
+}
+
+// stringSlices is an AttributesStruct generating []string values for every type.
+type stringSlices struct{}
+
+func (stringSlices) GetAttributeGivenType(reflect.Type) (attributes.Attributes, error) {
+	return attributes.SliceAttributes{MinLen: 1, MaxLen: 3, ElementAttrs: attributes.StringAttributes{MinLen: 1, MaxLen: 3}}, nil
+}
+
+func TestCheckMergeSorted_Unsupported(t *testing.T) {
+	alwaysEmpty := func(a, b []int) []int { return nil }
+	var upe UnsupportedParameterError
+	if _, err := CheckMergeSorted(alwaysEmpty, 10, stringSlices{}); !errors.As(err, &upe) || upe.Type != reflect.TypeFor[[]int]() {
+		t.Errorf("expected UnsupportedParameterError for attributes generating []string, got %v", err)
+	}
+}
@@ -0,0 +1,280 @@
+package pbtesting
+
+import (
+	"reflect"
+	"slices"
+)
+
+// maxShrinkSteps bounds the number of successful shrink steps taken for one failure, so
+// that shrinking always terminates even with a shrinker that never runs out of candidates.
+const maxShrinkSteps = 1000
+
+// Shrinker proposes simpler versions of a value that made a property fail. PBTest tries
+// the candidates in order and keeps the first one that still makes the property fail, then
+// asks for candidates again, until none does.
+//
+// Shrink must return values of the same type as value, and should return the most
+// aggressive simplifications (the zero value, the empty collection) first. Returning no
+// candidates ends shrinking for that value.
+//
+// Example usage:
+//
+//	// Shrink a Money value towards zero cents
+//	test.WithShrinker(reflect.TypeFor[Money](), pbtesting.ShrinkerFunc(func(v any) []any {
+//	    m := v.(Money)
+//	    if m.Cents == 0 {
+//	        return nil
+//	    }
+//	    return []any{Money{Currency: m.Currency}, Money{Currency: m.Currency, Cents: m.Cents / 2}}
+//	}))
+type Shrinker interface {
+	Shrink(value any) []any
+}
+
+// ShrinkerFunc adapts an ordinary function to the Shrinker interface.
+type ShrinkerFunc func(value any) []any
+
+func (f ShrinkerFunc) Shrink(value any) []any { return f(value) }
+
+// WithShrinker makes the test use s to shrink values of exactly type t, replacing the
+// built-in shrinker for its kind or a shrinker set before for t. Passing a nil Shrinker
+// removes it. Shrinkers belong to the test they are set on, so tests running in parallel
+// can shrink the same type differently.
+//
+// Built-in shrinkers exist for integers (towards zero), strings and slices (towards
+// shorter values, then simpler elements) and maps (towards fewer entries, then simpler
+// values). Values of other types are left as they are unless a shrinker is set.
+//
+// Parameters:
+//   - t: The type whose values s shrinks
+//   - s: The shrinker proposing simpler values of type t
+//
+// Returns the PBTest instance for method chaining.
+//
+// Example usage:
+//
+//	test.WithShrinker(reflect.TypeFor[Point](), pointShrinker{}).WithShrinking(true)
+func (pbt *PBTest) WithShrinker(t reflect.Type, s Shrinker) *PBTest {
+	if s == nil {
+		delete(pbt.shrinkers, t)
+		return pbt
+	}
+	if pbt.shrinkers == nil {
+		pbt.shrinkers = map[reflect.Type]Shrinker{}
+	}
+	pbt.shrinkers[t] = s
+	return pbt
+}
+
+// shrinkCandidates returns the candidates proposed for v by the shrinker in shrinkers for
+// its type, or by the built-in shrinker for its kind.
+func shrinkCandidates(v any, shrinkers map[reflect.Type]Shrinker) []any {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
+	if s, ok := shrinkers[t]; ok {
+		return s.Shrink(v)
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return shrinkInt(reflect.ValueOf(v))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return shrinkUint(reflect.ValueOf(v))
+	case reflect.String:
+		return shrinkString(reflect.ValueOf(v))
+	case reflect.Slice:
+		return shrinkSlice(reflect.ValueOf(v), shrinkers)
+	case reflect.Map:
+		return shrinkMap(reflect.ValueOf(v), shrinkers)
+	}
+	return nil
+}
+
+// shrinkInt proposes 0, half of v and v moved one step towards zero, preceded by -v for
+// negative values.
+func shrinkInt(v reflect.Value) []any {
+	n := v.Int()
+	if n == 0 {
+		return nil
+	}
+	var candidates []int64
+	if n < 0 && n != -n && !v.OverflowInt(-n) {
+		candidates = append(candidates, -n)
+	}
+	candidates = append(candidates, 0, n/2)
+	if n > 0 {
+		candidates = append(candidates, n-1)
+	} else {
+		candidates = append(candidates, n+1)
+	}
+	var out []any
+	for _, c := range compactInts(candidates, n) {
+		out = append(out, reflect.ValueOf(c).Convert(v.Type()).Interface())
+	}
+	return out
+}
+
+// shrinkUint proposes 0, half of v and v-1.
+func shrinkUint(v reflect.Value) []any {
+	n := v.Uint()
+	if n == 0 {
+		return nil
+	}
+	var out []any
+	for _, c := range []uint64{0, n / 2, n - 1} {
+		if c != n && (len(out) == 0 || reflect.ValueOf(out[len(out)-1]).Uint() != c) {
+			out = append(out, reflect.ValueOf(c).Convert(v.Type()).Interface())
+		}
+	}
+	return out
+}
+
+// compactInts drops the candidates equal to n and consecutive duplicates.
+func compactInts(candidates []int64, n int64) []int64 {
+	candidates = slices.DeleteFunc(candidates, func(c int64) bool { return c == n })
+	return slices.Compact(candidates)
+}
+
+// shrinkString proposes the empty string, each half and the string without its first or
+// last rune.
+func shrinkString(v reflect.Value) []any {
+	runes := []rune(v.String())
+	if len(runes) == 0 {
+		return nil
+	}
+	parts := []string{"", string(runes[:len(runes)/2]), string(runes[len(runes)/2:]), string(runes[1:]), string(runes[:len(runes)-1])}
+	var out []any
+	seen := map[string]bool{v.String(): true}
+	for _, part := range parts {
+		if !seen[part] {
+			seen[part] = true
+			out = append(out, reflect.ValueOf(part).Convert(v.Type()).Interface())
+		}
+	}
+	return out
+}
+
+// shrinkSlice proposes the empty slice, each half, the slice without each element and
+// finally the slice with one element replaced by each of its own candidates.
+func shrinkSlice(v reflect.Value, shrinkers map[reflect.Type]Shrinker) []any {
+	n := v.Len()
+	if n == 0 {
+		return nil
+	}
+	out := []any{reflect.MakeSlice(v.Type(), 0, 0).Interface()}
+	if n > 1 {
+		out = append(out, cloneSlice(v, 0, n/2), cloneSlice(v, n/2, n))
+	}
+	for i := 0; n > 2 && i < n; i++ {
+		without := reflect.AppendSlice(reflect.MakeSlice(v.Type(), 0, n-1), v.Slice(0, i))
+		out = append(out, reflect.AppendSlice(without, v.Slice(i+1, n)).Interface())
+	}
+	for i := 0; i < n; i++ {
+		for _, c := range shrinkCandidates(v.Index(i).Interface(), shrinkers) {
+			replaced := reflect.ValueOf(cloneSlice(v, 0, n))
+			replaced.Index(i).Set(reflect.ValueOf(c))
+			out = append(out, replaced.Interface())
+		}
+	}
+	return out
+}
+
+// cloneSlice returns a copy of v[from:to].
+func cloneSlice(v reflect.Value, from, to int) any {
+	return reflect.AppendSlice(reflect.MakeSlice(v.Type(), 0, to-from), v.Slice(from, to)).Interface()
+}
+
+// shrinkMap proposes the empty map, the map without each key and finally the map with one
+// value replaced by each of its own candidates.
+func shrinkMap(v reflect.Value, shrinkers map[reflect.Type]Shrinker) []any {
+	if v.Len() == 0 {
+		return nil
+	}
+	out := []any{reflect.MakeMap(v.Type()).Interface()}
+	keys := v.MapKeys()
+	for _, key := range keys {
+		if len(keys) > 1 {
+			without := cloneMap(v)
+			without.SetMapIndex(key, reflect.Value{})
+			out = append(out, without.Interface())
+		}
+	}
+	for _, key := range keys {
+		for _, c := range shrinkCandidates(v.MapIndex(key).Interface(), shrinkers) {
+			replaced := cloneMap(v)
+			replaced.SetMapIndex(key, reflect.ValueOf(c))
+			out = append(out, replaced.Interface())
+		}
+	}
+	return out
+}
+
+// cloneMap returns a shallow copy of the map v.
+func cloneMap(v reflect.Value) reflect.Value {
+	m := reflect.MakeMapWithSize(v.Type(), v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		m.SetMapIndex(iter.Key(), iter.Value())
+	}
+	return m
+}
+
+// shrink simplifies inputs that make the property fail, one argument at a time, using
+// the shrinkers set with WithShrinker and the built-in ones, and returns the simplest failing inputs found.
+// Arguments held with WithFixedArg or generated by WithArgGroup are left as they are, so
+// that the shrunk inputs remain inputs the run could have generated.
+func (pbt *PBTest) shrink(inputs []any) []any {
+	frozen := pbt.groupedArgs()
+	for index := range pbt.fixedArgs {
+		frozen[index] = true
+	}
+	current := inputs
+	for steps := 0; steps < maxShrinkSteps; steps++ {
+		next, ok := pbt.shrinkStep(current, frozen)
+		if !ok {
+			break
+		}
+		current = next
+	}
+	return current
+}
+
+// shrinkStep returns the first candidate obtained by shrinking one argument of inputs,
+// other than the frozen ones, that still satisfies the assumptions and makes the property
+// fail. Arguments are converted to their parameter type first, so that a shrinker
+// registered for a named type applies to generated values of its underlying type.
+func (pbt *PBTest) shrinkStep(inputs []any, frozen map[int]bool) ([]any, bool) {
+	for i, input := range inputs {
+		if frozen[i] {
+			continue
+		}
+		for _, c := range shrinkCandidates(pbt.asParamType(input, i), pbt.shrinkers) {
+			candidate := slices.Clone(inputs)
+			candidate[i] = c
+			if pbt.assumed(candidate) && anyFailed(pbt.evaluate(candidate)) {
+				return candidate, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// asParamType converts input to the type of parameter i of the function under test when
+// it has a different but convertible type, and returns it unchanged otherwise.
+func (pbt *PBTest) asParamType(input any, i int) any {
+	fType := reflect.TypeOf(pbt.f)
+	if input == nil || fType == nil || fType.Kind() != reflect.Func || (!fType.IsVariadic() && i >= fType.NumIn()) {
+		return input
+	}
+	want := paramType(fType, i)
+	if t := reflect.TypeOf(input); t != want && t.ConvertibleTo(want) {
+		return reflect.ValueOf(input).Convert(want).Interface()
+	}
+	return input
+}
+
+// anyFailed reports whether any of results did not pass.
+func anyFailed(results []PBTestOut) bool {
+	return slices.ContainsFunc(results, func(r PBTestOut) bool { return !r.Passed() })
+}
@@ -0,0 +1,105 @@
+package pbtesting
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/laiambryant/gotestutils/ftesting/attributes"
+)
+
+type lessThan struct{ max int }
+
+func (p lessThan) Verify(v any) bool { return v.(int) < p.max }
+
+func TestShrinkCandidates(t *testing.T) {
+	type level int8
+	cases := []struct {
+		in   any
+		want []any
+	}{
+		{0, nil},
+		{93, []any{0, 46, 92}},
+		{level(-128), []any{level(0), level(-64), level(-127)}},
+		{-6, []any{6, 0, -3, -5}},
+		{uint(1), []any{uint(0)}},
+		{"abc", []any{"", "a", "bc", "ab"}},
+		{[]int{2}, []any{[]int{}, []int{0}, []int{1}}},
+		{map[string]int{"a": 1}, []any{map[string]int{}, map[string]int{"a": 0}}},
+		{3.5, nil},
+	}
+	for _, c := range cases {
+		if got := shrinkCandidates(c.in, nil); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("shrinkCandidates(%#v) = %#v, expected %#v", c.in, got, c.want)
+		}
+	}
+	if got := shrinkCandidates([]int{1, 2, 3}, nil); len(got) != 1+2+3+6 {
+		t.Errorf("expected empty, halves, removals and element shrinks, got %v", got)
+	}
+}
+
+func TestRun_WithShrinking(t *testing.T) {
+	attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(60, 100))
+	results, err := NewPBTest(func(n int) int { return n }).WithIterations(5).WithPredicates(lessThan{50}).WithShrinking(true).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, r := range FilterPBTTestOut(results) {
+		if r.Inputs[0] != 50 || r.Output != 50 || r.ShrunkFrom[0].(int) < 60 {
+			t.Fatalf("expected the failure to shrink to the boundary 50, got %+v", r)
+		}
+	}
+	attrs.SliceAttr = attributes.SliceAttributes{MinLen: 4, MaxLen: 6, ElementAttrs: attributes.IntegerAttributesImpl[int]{Min: 1, Max: 100}}
+	results, _ = NewPBTest(func(xs []int) int { return len(xs) }).WithIterations(5).WithPredicates(lessThan{3}).WithShrinking(true).RunWithAttributes(attrs)
+	for _, r := range FilterPBTTestOut(results) {
+		if !reflect.DeepEqual(r.Inputs, []any{[]int{0, 0, 0}}) {
+			t.Fatalf("expected the failure to shrink to three zeros, got %v", r.Inputs)
+		}
+	}
+}
+
+func TestRun_WithShrinkingKeepsFixedAndGroupedArgs(t *testing.T) {
+	attrs := attributes.NewFTAttributes(attributes.WithIntegerRange(60, 100))
+	sum := func(a, b, start, end int) int { return a + b + start + end }
+	interval := attributes.IntervalAttributes{Bounds: attributes.IntegerAttributesImpl[int]{Min: 60, Max: 100}}
+	results, err := NewPBTest(sum).WithIterations(5).WithPredicates(lessThan{50}).WithShrinking(true).
+		WithFixedArg(1, 37).WithArgGroup(2, interval).RunWithAttributes(attrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	failures := FilterPBTTestOut(results)
+	if len(failures) == 0 {
+		t.Fatal("expected failures to shrink")
+	}
+	for _, r := range failures {
+		if r.Inputs[0] != 0 || r.Inputs[1] != 37 {
+			t.Fatalf("expected only the free argument to shrink and the fixed one to stay 37, got %v", r.Inputs)
+		}
+		if r.Inputs[2] != r.ShrunkFrom[2] || r.Inputs[3] != r.ShrunkFrom[3] {
+			t.Fatalf("expected the grouped arguments to stay as generated, got %v from %v", r.Inputs, r.ShrunkFrom)
+		}
+	}
+}
+
+type shrinkLevel int
+
+func TestWithShrinker(t *testing.T) {
+	levelType := reflect.TypeFor[shrinkLevel]()
+	test := NewPBTest(func(l shrinkLevel) int { return int(l) }).WithIterations(3).WithPredicates(lessThan{0}).WithShrinking(true)
+	test.WithShrinker(levelType, ShrinkerFunc(func(any) []any { return nil }))
+	if got := shrinkCandidates(shrinkLevel(5), test.shrinkers); got != nil {
+		t.Fatalf("expected the shrinker to replace the built-in one, got %v", got)
+	}
+	if got := shrinkCandidates(shrinkLevel(5), NewPBTest(nil).shrinkers); len(got) == 0 {
+		t.Fatal("expected other tests to keep the built-in shrinker")
+	}
+	results, _ := test.RunWithAttributes(attributes.NewFTAttributes(attributes.WithIntegerRange(10, 20)))
+	for _, r := range FilterPBTTestOut(results) {
+		if !reflect.DeepEqual(r.Inputs, r.ShrunkFrom) {
+			t.Fatalf("expected inputs without candidates to stay as generated, got %v from %v", r.Inputs, r.ShrunkFrom)
+		}
+	}
+	test.WithShrinker(levelType, nil)
+	if got := shrinkCandidates(shrinkLevel(5), test.shrinkers); len(got) == 0 {
+		t.Error("expected the built-in shrinker after removing the shrinker")
+	}
+}
@@ -0,0 +1,75 @@
+package stesting
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// RunConcurrentStressTest runs the stress test function from several goroutines at once
+// and checks an invariant on the collected results. The goroutines share whatever state F
+// closes over and are released together, so that their calls overlap as much as possible;
+// this exposes lost updates and other logical races that a sequential run, and sometimes
+// the race detector, cannot see.
+//
+// Type parameters:
+//   - fRetType: the return type of the function being tested (must be comparable)
+//   - testVarType: the type of test variables used (must be comparable)
+//
+// Parameters:
+//   - stressTest: pointer to the StressTest instance; its iterations are spread over the goroutines
+//   - goroutines: the number of goroutines calling F concurrently (at least one is used)
+//   - invariant: a check on the results of all successful calls, in completion order,
+//     returning a non-nil error when it is violated (for example when the final counter
+//     differs from the number of increments); nil skips the check
+//
+// Returns:
+//   - results: the values returned by the successful calls, in completion order
+//   - success: true if every call succeeded and the invariant held
+//   - err: nil on success, a StressTestingError for the first failing call (indexed in
+//     start order), or an InvariantViolationError wrapping the invariant's error
+//
+// Every call is made even when one fails, so that the invariant sees the state left by a
+// complete run; the invariant is only checked when no call failed. For a stress test built
+// with NewSeededStressTest, every goroutine calls the function with a source of its own.
+func RunConcurrentStressTest[fRetType comparable, testVarType comparable](
+	stressTest *StressTest[fRetType, testVarType],
+	goroutines uint32,
+	invariant func(results []fRetType) error,
+) (results []fRetType, success bool, err error) {
+	goroutines = max(goroutines, 1)
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		next  atomic.Uint32
+		start = make(chan struct{})
+	)
+	wg.Add(int(goroutines))
+	for worker := range goroutines {
+		go func() {
+			defer wg.Done()
+			f := stressTest.workerF(worker)
+			<-start
+			for i := next.Add(1) - 1; i < stressTest.iterations; i = next.Add(1) - 1 {
+				out, callErr := f()
+				mu.Lock()
+				if callErr == nil {
+					results = append(results, out)
+				} else if err == nil {
+					err = StressTestingError{Index: i, Err: callErr}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	if err != nil {
+		return results, false, err
+	}
+	if invariant != nil {
+		if violation := invariant(results); violation != nil {
+			return results, false, InvariantViolationError{Calls: uint32(len(results)), Err: violation}
+		}
+	}
+	return results, true, nil
+}
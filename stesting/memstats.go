@@ -0,0 +1,97 @@
+package stesting
+
+import "runtime"
+
+// defaultMemSamples is the number of heap samples taken during a run when no
+// sampling interval is given.
+const defaultMemSamples = 10
+
+// MemStatsReport summarizes the memory behaviour of a stress test run.
+//
+// Fields:
+//   - HeapBefore: Live heap bytes measured after a forced collection before the run
+//   - HeapAfter: Live heap bytes measured after a forced collection after the run
+//   - HeapGrowth: HeapAfter minus HeapBefore (negative when the heap shrank)
+//   - TotalAlloc: Cumulative bytes allocated during the run, including freed memory
+//   - Mallocs: Number of heap objects allocated during the run
+//   - NumGC: Number of completed GC cycles during the run, including forced ones
+//   - HeapSamples: Live heap bytes measured after a forced collection at each sampling point
+//
+// Use LikelyLeak to check whether the live heap grew at every sampling point.
+type MemStatsReport struct {
+	HeapBefore  uint64
+	HeapAfter   uint64
+	HeapGrowth  int64
+	TotalAlloc  uint64
+	Mallocs     uint64
+	NumGC       uint32
+	HeapSamples []uint64
+}
+
+// LikelyLeak reports whether the live heap grew strictly between every pair of
+// consecutive samples, which is the signature of memory retained across iterations.
+// At least three samples are required for the check to be meaningful.
+func (r MemStatsReport) LikelyLeak() bool {
+	if len(r.HeapSamples) < 3 {
+		return false
+	}
+	for i := 1; i < len(r.HeapSamples); i++ {
+		if r.HeapSamples[i] <= r.HeapSamples[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// RunStressTestWithMemStats executes a stress test sequentially, like RunStressTest,
+// while sampling runtime.ReadMemStats before, during and after the run.
+//
+// Type parameters:
+//   - fRetType: the return type of the function being tested (must be comparable)
+//   - testVarType: the type of test variables used (must be comparable)
+//
+// Parameters:
+//   - stressTest: pointer to StressTest struct containing the test configuration
+//   - sampleEvery: number of iterations between heap samples; 0 spreads ten samples over the run
+//
+// Returns:
+//   - report: heap growth, allocation and GC statistics for the run
+//   - success: true if all iterations passed, false if any iteration failed
+//   - err: nil on success, StressTestingError on failure containing iteration details
+//
+// Each sample forces a garbage collection so that it measures the live heap rather than
+// garbage awaiting collection; this slows the run down and is counted in NumGC. The report
+// is filled in up to the failing iteration when an error occurs.
+func RunStressTestWithMemStats[fRetType comparable, testVarType comparable](
+	stressTest *StressTest[fRetType, testVarType],
+	sampleEvery uint32,
+) (report MemStatsReport, success bool, err error) {
+	if sampleEvery == 0 {
+		sampleEvery = max(stressTest.iterations/defaultMemSamples, 1)
+	}
+	before := readLiveMemStats()
+	report.HeapBefore = before.HeapAlloc
+	for i := uint32(0); i < stressTest.iterations; i++ {
+		if _, err = stressTest.F(); err != nil {
+			err = StressTestingError{Index: i, Err: err}
+			break
+		}
+		if (i+1)%sampleEvery == 0 {
+			report.HeapSamples = append(report.HeapSamples, readLiveMemStats().HeapAlloc)
+		}
+	}
+	after := readLiveMemStats()
+	report.HeapAfter = after.HeapAlloc
+	report.HeapGrowth = int64(after.HeapAlloc) - int64(before.HeapAlloc)
+	report.TotalAlloc = after.TotalAlloc - before.TotalAlloc
+	report.Mallocs = after.Mallocs - before.Mallocs
+	report.NumGC = after.NumGC - before.NumGC
+	return report, err == nil, err
+}
+
+// readLiveMemStats forces a garbage collection and returns the resulting memory statistics.
+func readLiveMemStats() (stats runtime.MemStats) {
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	return stats
+}
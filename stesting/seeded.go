@@ -0,0 +1,61 @@
+package stesting
+
+import (
+	"math/rand"
+
+	gtu "github.com/laiambryant/gotestutils/testing"
+)
+
+// SeededFunc is a stress test function drawing its randomness from the source it is
+// given instead of the global one of math/rand.
+type SeededFunc[fRetType comparable] func(r *rand.Rand) (fRetType, error)
+
+// NewSeededStressTest creates a StressTest for a function that uses randomness. Parallel
+// workers calling the top-level functions of math/rand serialize on the mutex of the
+// global source and make runs unreproducible; instead, RunParallelStressTest and
+// RunConcurrentStressTest give every worker its own *rand.Rand seeded with
+// baseSeed+workerID, workers being numbered from 0. The random values seen by each worker
+// are then the same on every run, although which iterations a worker picks up depends on
+// scheduling. The sequential runners call F, which draws from a single source seeded with
+// baseSeed and must therefore not be called from several goroutines.
+//
+// Type parameters:
+//   - fRetType: the return type of the function being tested, must be comparable
+//   - testVarType: the type of test variables, must be comparable
+//
+// Parameters:
+//   - iterations: the number of times to execute the test function
+//   - f: the test function, called with the source of the worker running it
+//   - baseSeed: the seed of worker 0; worker i uses baseSeed+i
+//   - testVar: pointer to the test variables used by the function
+//
+// Returns:
+//   - stressTest: a configured StressTest instance ready for execution
+//
+// Example usage:
+//
+//	stressTest := stesting.NewSeededStressTest[int, int](10000, func(r *rand.Rand) (int, error) {
+//	    return cache.Get(r.Intn(1000)), nil
+//	}, 42, nil)
+//	ok, err := stesting.RunParallelStressTest(&stressTest, 8)
+func NewSeededStressTest[fRetType comparable, testVarType comparable](
+	iterations uint32,
+	f SeededFunc[fRetType],
+	baseSeed int64,
+	testVar *testVarType,
+) (stressTest StressTest[fRetType, testVarType]) {
+	r := rand.New(rand.NewSource(baseSeed))
+	stressTest = NewStressTest(iterations, func() (fRetType, error) { return f(r) }, testVar)
+	stressTest.seededF, stressTest.baseSeed = f, baseSeed
+	return stressTest
+}
+
+// workerF returns the function called by the worker with the given ID: F, or for a
+// seeded stress test its function bound to a source seeded with baseSeed+worker.
+func (st *StressTest[fRetType, testVarType]) workerF(worker uint32) gtu.TestFunc[fRetType] {
+	if st.seededF == nil {
+		return st.F
+	}
+	r := rand.New(rand.NewSource(st.baseSeed + int64(worker)))
+	return func() (fRetType, error) { return st.seededF(r) }
+}
@@ -19,6 +19,9 @@ import (
 //   - iterations: The number of times the test function will be executed
 //   - testVar: A pointer to the test variable used during stress testing
 //   - F: The test function to be executed, must conform to gtu.TestFunc[fRetType]
+//   - seededF: For tests built with NewSeededStressTest, the function parallel workers call
+//     with a source of their own
+//   - baseSeed: The seed of the source of worker 0 when seededF is set
 //
 // This struct is designed to facilitate performance and reliability testing
 // by running the same test function multiple times and collecting results.
@@ -26,6 +29,8 @@ type StressTest[fRetType comparable, testVarType comparable] struct {
 	iterations uint32
 	testVar    *testVarType
 	F          gtu.TestFunc[fRetType]
+	seededF    SeededFunc[fRetType]
+	baseSeed   int64
 }
 
 // NewStressTest creates a new StressTest instance for running stress tests on a function.
@@ -101,7 +106,8 @@ func RunStressTest[fRetType comparable, testVarType comparable](
 //     error encountered during execution
 //
 // The function stops execution and returns immediately upon encountering the first error.
-// All workers are properly synchronized and cleaned up before returning.
+// All workers are properly synchronized and cleaned up before returning. For a stress test
+// built with NewSeededStressTest, every worker calls the function with a source of its own.
 func RunParallelStressTest[fRetType comparable, testVarType comparable](
 	stressTest *StressTest[fRetType, testVarType],
 	maxWorkers uint32,
@@ -109,10 +115,10 @@ func RunParallelStressTest[fRetType comparable, testVarType comparable](
 	errchan, jobs := make(chan error, stressTest.iterations), make(chan uint32)
 	var wg sync.WaitGroup
 	wg.Add(int(maxWorkers))
-	for range maxWorkers {
+	for worker := range maxWorkers {
 		go func() {
 			defer wg.Done()
-			workerFunc(jobs, stressTest, errchan)
+			workerFunc(jobs, stressTest.workerF(worker), errchan)
 		}()
 	}
 	go func() {
@@ -141,19 +147,18 @@ func RunParallelStressTest[fRetType comparable, testVarType comparable](
 //
 // Type parameters:
 //   - fRetType: the return type of the stress test function (must be comparable)
-//   - testVarType: the type of test variables used in the stress test (must be comparable)
 //
 // Parameters:
 //   - jobs: receive-only channel containing iteration indices to process
-//   - stressTest: pointer to the StressTest instance containing the function to execute
+//   - f: the stress test function this worker executes
 //   - errchan: send-only channel for communicating results back to the coordinator
 //
 // For each job received, the function executes the stress test and sends either:
 //   - nil to errchan if the test iteration succeeds
 //   - StressTestingError to errchan if the test iteration fails, containing the index and error
-func workerFunc[fRetType comparable, testVarType comparable](jobs <-chan uint32, stressTest *StressTest[fRetType, testVarType], errchan chan<- error) {
+func workerFunc[fRetType comparable](jobs <-chan uint32, f gtu.TestFunc[fRetType], errchan chan<- error) {
 	for range jobs {
-		_, err := stressTest.F()
+		_, err := f()
 		if err != nil {
 			errchan <- StressTestingError{Err: err}
 		} else {
@@ -10,3 +10,45 @@ type StressTestingError struct {
 func (s StressTestingError) Error() string {
 	return "Error while running stress test at step " + fmt.Sprint(s.Index) + " of testing: " + s.Err.Error()
 }
+
+// InvariantViolationError is returned by RunConcurrentStressTest when the invariant
+// rejects the results of the concurrent calls.
+//
+// Fields:
+//   - Calls: The number of calls whose results were checked
+//   - Err: The error returned by the invariant
+type InvariantViolationError struct {
+	Calls uint32
+	Err   error
+}
+
+func (i InvariantViolationError) Error() string {
+	return "Invariant violated after " + fmt.Sprint(i.Calls) + " concurrent calls: " + i.Err.Error()
+}
+
+func (i InvariantViolationError) Unwrap() error { return i.Err }
+
+// WeightedFuncError is returned by the stress tests built with NewWeightedStressTest
+// when an operation fails, naming the operation that produced the error.
+//
+// Fields:
+//   - Name: The name of the failing operation
+//   - Err: The error it returned
+type WeightedFuncError struct {
+	Name string
+	Err  error
+}
+
+func (w WeightedFuncError) Error() string {
+	return "Operation " + w.Name + " failed: " + w.Err.Error()
+}
+
+func (w WeightedFuncError) Unwrap() error { return w.Err }
+
+// NoWeightedFuncError is returned by the stress tests built with NewWeightedStressTest
+// when no operation has a positive weight, so that none can be picked.
+type NoWeightedFuncError struct{}
+
+func (NoWeightedFuncError) Error() string {
+	return "No operation of the weighted stress test has a positive weight"
+}
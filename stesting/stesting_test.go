@@ -3,7 +3,11 @@ package stesting
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"os"
+	"reflect"
+	"runtime"
+	"sync"
 	"sync/atomic"
 	"testing"
 )
@@ -22,6 +26,9 @@ var (
 	testFuncWithErr = func() (bool, error) {
 		return false, errors.New("error")
 	}
+	testFuncWithErrInt = func() (int, error) {
+		return 0, errors.New("error")
+	}
 )
 
 func assertSuccessNoError(t *testing.T, success bool, err error) {
@@ -207,3 +214,219 @@ func TestCreateAndOpenFileError(t *testing.T) {
 		f.Close()
 	}
 }
+
+var leaked [][]byte
+
+func TestRunStressTestWithMemStats(t *testing.T) {
+	stressTest := NewStressTest[bool, int](50, testFunc, nil)
+	report, success, err := RunStressTestWithMemStats(&stressTest, 0)
+	assertSuccessNoError(t, success, err)
+	if len(report.HeapSamples) != 10 {
+		t.Errorf("Expected 10 heap samples, got %d", len(report.HeapSamples))
+	}
+	if report.NumGC == 0 {
+		t.Error("Expected forced collections to be counted in NumGC")
+	}
+	if report.HeapGrowth != int64(report.HeapAfter)-int64(report.HeapBefore) {
+		t.Errorf("Expected HeapGrowth to be HeapAfter-HeapBefore, got %d", report.HeapGrowth)
+	}
+}
+
+func TestRunStressTestWithMemStatsDetectsLeak(t *testing.T) {
+	defer func() { leaked = nil }()
+	leakyFunc := func() (bool, error) {
+		leaked = append(leaked, make([]byte, 64*1024))
+		return true, nil
+	}
+	stressTest := NewStressTest[bool, int](20, leakyFunc, nil)
+	report, success, err := RunStressTestWithMemStats(&stressTest, 4)
+	assertSuccessNoError(t, success, err)
+	if !report.LikelyLeak() {
+		t.Errorf("Expected a likely leak, heap samples: %v", report.HeapSamples)
+	}
+	if report.HeapGrowth < 20*64*1024 {
+		t.Errorf("Expected heap growth of at least %d bytes, got %d", 20*64*1024, report.HeapGrowth)
+	}
+	if report.TotalAlloc < 20*64*1024 || report.Mallocs < 20 {
+		t.Errorf("Expected allocations to be reported, got %d bytes in %d objects", report.TotalAlloc, report.Mallocs)
+	}
+}
+
+func TestRunStressTestWithMemStatsError(t *testing.T) {
+	stressTest := NewStressTest[bool, int](10, testFuncWithErr, nil)
+	_, success, err := RunStressTestWithMemStats(&stressTest, 1)
+	assertNoSuccessError(t, success, err)
+	var ste StressTestingError
+	if !errors.As(err, &ste) || ste.Index != 0 {
+		t.Errorf("Expected StressTestingError at index 0, got %v", err)
+	}
+}
+
+func TestMemStatsReportLikelyLeak(t *testing.T) {
+	cases := []struct {
+		samples []uint64
+		want    bool
+	}{
+		{nil, false},
+		{[]uint64{1, 2}, false},
+		{[]uint64{1, 2, 3}, true},
+		{[]uint64{1, 3, 3}, false},
+		{[]uint64{3, 2, 4}, false},
+	}
+	for _, c := range cases {
+		if got := (MemStatsReport{HeapSamples: c.samples}).LikelyLeak(); got != c.want {
+			t.Errorf("LikelyLeak(%v) = %v, want %v", c.samples, got, c.want)
+		}
+	}
+}
+
+func TestRunConcurrentStressTest(t *testing.T) {
+	var counter atomic.Int64
+	increment := func() (int64, error) { return counter.Add(1), nil }
+	stressTest := NewStressTest[int64, int](1000, increment, nil)
+	results, success, err := RunConcurrentStressTest(&stressTest, 8, func(results []int64) error {
+		if got := counter.Load(); got != int64(len(results)) {
+			return fmt.Errorf("counter is %d after %d increments", got, len(results))
+		}
+		return nil
+	})
+	assertSuccessNoError(t, success, err)
+	if len(results) != 1000 {
+		t.Errorf("Expected 1000 results, got %d", len(results))
+	}
+}
+
+func TestRunConcurrentStressTestDetectsLostUpdates(t *testing.T) {
+	var counter atomic.Int64
+	lostUpdate := func() (int64, error) {
+		v := counter.Load()
+		runtime.Gosched()
+		counter.Store(v + 1)
+		return v + 1, nil
+	}
+	stressTest := NewStressTest[int64, int](1000, lostUpdate, nil)
+	_, success, err := RunConcurrentStressTest(&stressTest, 8, func(results []int64) error {
+		if got := counter.Load(); got != int64(len(results)) {
+			return fmt.Errorf("counter is %d after %d increments", got, len(results))
+		}
+		return nil
+	})
+	assertNoSuccessError(t, success, err)
+	var ive InvariantViolationError
+	if !errors.As(err, &ive) || ive.Calls != 1000 {
+		t.Errorf("Expected InvariantViolationError after 1000 calls, got %v", err)
+	}
+}
+
+func TestRunConcurrentStressTestError(t *testing.T) {
+	var calls atomic.Int32
+	stressTest := NewStressTest[bool, int](20, func() (bool, error) {
+		calls.Add(1)
+		return testFuncWithErr()
+	}, nil)
+	results, success, err := RunConcurrentStressTest(&stressTest, 4, nil)
+	assertNoSuccessError(t, success, err)
+	var ste StressTestingError
+	if !errors.As(err, &ste) {
+		t.Errorf("Expected StressTestingError, got %v", err)
+	}
+	if calls.Load() != 20 || len(results) != 0 {
+		t.Errorf("Expected every call to be made and none to succeed, got %d calls and %d results", calls.Load(), len(results))
+	}
+}
+
+func TestInvariantViolationError(t *testing.T) {
+	err := InvariantViolationError{Calls: 3, Err: errors.New("boom")}
+	if err.Error() != "Invariant violated after 3 concurrent calls: boom" {
+		t.Errorf("Unexpected error message: %s", err.Error())
+	}
+	if !errors.Is(err, err.Err) {
+		t.Error("Expected InvariantViolationError to unwrap to the invariant error")
+	}
+}
+
+func TestNewWeightedStressTest(t *testing.T) {
+	var inserts, queries atomic.Int64
+	stressTest := NewWeightedStressTest[int, int](4000, []WeightedFunc[int]{
+		{Name: "insert", F: func() (int, error) { return int(inserts.Add(1)), nil }, Weight: 1},
+		{Name: "query", F: func() (int, error) { return int(queries.Add(1)), nil }, Weight: 3},
+		{Name: "never", F: testFuncWithErrInt, Weight: 0},
+	}, nil)
+	results, success, err := RunConcurrentStressTest(&stressTest, 4, nil)
+	assertSuccessNoError(t, success, err)
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Name]++
+	}
+	if counts["insert"] != int(inserts.Load()) || counts["query"] != int(queries.Load()) || counts["never"] != 0 {
+		t.Errorf("expected outputs attributed to their operation, got %v", counts)
+	}
+	if counts["insert"] == 0 || counts["query"] < 2*counts["insert"] {
+		t.Errorf("expected operations picked in proportion to their weights, got %v", counts)
+	}
+}
+
+func TestNewWeightedStressTestError(t *testing.T) {
+	stressTest := NewWeightedStressTest[int, int](10, []WeightedFunc[int]{{F: testFuncWithErrInt, Weight: 1}}, nil)
+	success, err := RunStressTest(&stressTest)
+	assertNoSuccessError(t, success, err)
+	var wfe WeightedFuncError
+	if !errors.As(err.(StressTestingError).Err, &wfe) || wfe.Name != "func 0" {
+		t.Errorf("expected a WeightedFuncError naming the operation, got %v", err)
+	}
+	if err.Error() != "Error while running stress test at step 0 of testing: Operation func 0 failed: error" {
+		t.Errorf("unexpected error message: %v", err)
+	}
+	empty := NewWeightedStressTest[int, int](1, nil, nil)
+	if _, err := RunStressTest(&empty); !errors.As(err.(StressTestingError).Err, new(NoWeightedFuncError)) {
+		t.Errorf("expected NoWeightedFuncError without weights, got %v", err)
+	}
+}
+
+func TestNewSeededStressTest(t *testing.T) {
+	var mu sync.Mutex
+	draws := map[*rand.Rand][]int{}
+	stressTest := NewSeededStressTest[int, int](400, func(r *rand.Rand) (int, error) {
+		n := r.Int()
+		mu.Lock()
+		draws[r] = append(draws[r], n)
+		mu.Unlock()
+		return n, nil
+	}, 42, nil)
+	success, err := RunParallelStressTest(&stressTest, 4)
+	assertSuccessNoError(t, success, err)
+	if len(draws) == 0 || len(draws) > 4 {
+		t.Fatalf("expected one source per worker, got %d", len(draws))
+	}
+	matched := map[int]bool{}
+	for _, got := range draws {
+		worker := -1
+		for i := range 4 {
+			want := rand.New(rand.NewSource(42 + int64(i)))
+			if !matched[i] && want.Int() == got[0] {
+				worker = i
+				break
+			}
+		}
+		if worker < 0 {
+			t.Fatalf("expected every worker to draw from a source seeded with 42+workerID, got %v", got[:1])
+		}
+		matched[worker] = true
+		want := rand.New(rand.NewSource(42 + int64(worker)))
+		for j, n := range got {
+			if w := want.Int(); n != w {
+				t.Fatalf("worker %d: draw %d is %d, expected %d", worker, j, n, w)
+			}
+		}
+	}
+	sequential := rand.New(rand.NewSource(42))
+	var want, got []int
+	for range 5 {
+		want = append(want, sequential.Int())
+		n, _ := stressTest.F()
+		got = append(got, n)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected F to draw from a source seeded with the base seed, got %v want %v", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+package stesting
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	gtu "github.com/laiambryant/gotestutils/testing"
+)
+
+// WeightedFunc bundles one operation of a mixed workload with its relative weight.
+//
+// Type parameters:
+//   - fRetType: The comparable return type of the operation
+//
+// Fields:
+//   - Name: The name reported with the outputs and errors of the operation; defaults to
+//     "func <index>" when empty
+//   - F: The operation
+//   - Weight: The relative frequency of the operation; an operation with weight 0 is
+//     never run
+type WeightedFunc[fRetType comparable] struct {
+	Name   string
+	F      gtu.TestFunc[fRetType]
+	Weight uint32
+}
+
+// WeightedOutput is the output of one iteration of a weighted stress test, attributed to
+// the operation that produced it.
+//
+// Fields:
+//   - Name: The name of the operation picked for the iteration
+//   - Out: The value it returned
+type WeightedOutput[fRetType comparable] struct {
+	Name string
+	Out  fRetType
+}
+
+// NewWeightedStressTest creates a StressTest interleaving several operations, such as
+// inserts, deletes and queries against one system, to model a realistic mixed workload
+// instead of hammering a single operation. Every iteration picks one of the functions at
+// random in proportion to its weight. The outputs are WeightedOutput values naming the
+// operation, and the errors are WeightedFuncError values naming it too, so the returned
+// StressTest works with every runner of the package while keeping results attributable.
+//
+// Type parameters:
+//   - fRetType: the return type of the operations, must be comparable
+//   - testVarType: the type of test variables, must be comparable
+//
+// Parameters:
+//   - iterations: the number of operations to run
+//   - funcs: the operations and their weights
+//   - testVar: pointer to the test variables used by the operations
+//
+// Returns:
+//   - stressTest: a configured StressTest instance ready for execution; when no function
+//     has a positive weight, every iteration fails with a NoWeightedFuncError
+//
+// Example usage:
+//
+//	stressTest := stesting.NewWeightedStressTest[int, int](10000, []stesting.WeightedFunc[int]{
+//	    {Name: "insert", F: insert, Weight: 3},
+//	    {Name: "delete", F: remove, Weight: 1},
+//	    {Name: "query", F: query, Weight: 6},
+//	}, nil)
+//	results, ok, err := stesting.RunConcurrentStressTest(&stressTest, 8, nil)
+func NewWeightedStressTest[fRetType comparable, testVarType comparable](
+	iterations uint32,
+	funcs []WeightedFunc[fRetType],
+	testVar *testVarType,
+) (stressTest StressTest[WeightedOutput[fRetType], testVarType]) {
+	funcs = append([]WeightedFunc[fRetType](nil), funcs...)
+	var total uint64
+	for i := range funcs {
+		if funcs[i].Name == "" {
+			funcs[i].Name = fmt.Sprintf("func %d", i)
+		}
+		total += uint64(funcs[i].Weight)
+	}
+	pick := func() (out WeightedOutput[fRetType], err error) {
+		if total == 0 {
+			return out, NoWeightedFuncError{}
+		}
+		f := pickWeighted(funcs, rand.Uint64N(total))
+		out.Name = f.Name
+		if out.Out, err = f.F(); err != nil {
+			err = WeightedFuncError{Name: f.Name, Err: err}
+		}
+		return out, err
+	}
+	return NewStressTest(iterations, pick, testVar)
+}
+
+// pickWeighted returns the function whose share of the cumulative weights contains r,
+// which must be lower than the sum of the weights.
+func pickWeighted[fRetType comparable](funcs []WeightedFunc[fRetType], r uint64) WeightedFunc[fRetType] {
+	for _, f := range funcs {
+		if r < uint64(f.Weight) {
+			return f
+		}
+		r -= uint64(f.Weight)
+	}
+	return funcs[len(funcs)-1]
+}
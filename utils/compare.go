@@ -0,0 +1,39 @@
+package utils
+
+import "reflect"
+
+// IsOrdered reports whether values of type t can be compared with Less, that is
+// whether t is an integer, unsigned integer, float or string type (including named
+// types with one of those underlying kinds).
+func IsOrdered(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+	return false
+}
+
+// Less reports whether a orders strictly before b. Both values must have the same
+// ordered kind (see IsOrdered); for any other combination Less returns false.
+func Less(a, b any) bool {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !av.IsValid() || !bv.IsValid() || av.Kind() != bv.Kind() {
+		return false
+	}
+	switch av.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return av.Int() < bv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return av.Uint() < bv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return av.Float() < bv.Float()
+	case reflect.String:
+		return av.String() < bv.String()
+	}
+	return false
+}
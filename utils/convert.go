@@ -0,0 +1,50 @@
+package utils
+
+import "reflect"
+
+// NumericFamily returns the first kind of the numeric family of k (reflect.Int for signed
+// integers, reflect.Uint for unsigned ones, reflect.Float64 and reflect.Complex128), so
+// that kinds of the same family compare equal, or reflect.Invalid when k is not numeric.
+func NumericFamily(k reflect.Kind) reflect.Kind {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	case reflect.Complex64, reflect.Complex128:
+		return reflect.Complex128
+	}
+	return reflect.Invalid
+}
+
+// Overflows reports whether the number v cannot be represented by the numeric type want,
+// which must be of the same family as v (see NumericFamily).
+func Overflows(v reflect.Value, want reflect.Type) bool {
+	target := reflect.Zero(want)
+	switch NumericFamily(want.Kind()) {
+	case reflect.Int:
+		return target.OverflowInt(v.Int())
+	case reflect.Uint:
+		return target.OverflowUint(v.Uint())
+	case reflect.Float64:
+		return target.OverflowFloat(v.Float())
+	}
+	return target.OverflowComplex(v.Complex())
+}
+
+// ConvertsLosslessly reports whether v can be converted to t without losing information:
+// numbers only convert within their numeric family and when t can represent them, so
+// that a float never becomes an int nor an int64 wraps into an int8, while non-numeric
+// values convert whenever reflect allows it, such as a string to a named string type.
+func ConvertsLosslessly(v reflect.Value, t reflect.Type) bool {
+	if !v.Type().ConvertibleTo(t) {
+		return false
+	}
+	family := NumericFamily(v.Kind())
+	if family != NumericFamily(t.Kind()) {
+		return false
+	}
+	return family == reflect.Invalid || !Overflows(v, t)
+}
@@ -0,0 +1,83 @@
+package utils
+
+import "reflect"
+
+// DeepCopy returns a copy of v sharing no slice, map or pointer with it, recursing into
+// arrays, structs and interfaces, so that code modifying its arguments in place cannot
+// affect the original. Unexported struct fields cannot be set through reflection and are
+// copied shallowly; functions and channels are shared.
+func DeepCopy(v any) any {
+	if v == nil {
+		return nil
+	}
+	return copyValue(reflect.ValueOf(v), map[uintptr]reflect.Value{}).Interface()
+}
+
+// DeepCopyAll returns a slice holding the DeepCopy of every element of values, such as
+// the arguments of a function call.
+func DeepCopyAll(values []any) []any {
+	copies := make([]any, len(values))
+	for i, v := range values {
+		copies[i] = DeepCopy(v)
+	}
+	return copies
+}
+
+// copyValue deep copies v, using copies to preserve the sharing and cycles of pointers.
+func copyValue(v reflect.Value, copies map[uintptr]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		if c, ok := copies[v.Pointer()]; ok && c.Type() == v.Type() {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		copies[v.Pointer()] = c
+		c.Elem().Set(copyValue(v.Elem(), copies))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i), copies))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value(), copies))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i), copies))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(copyValue(v.Field(i), copies))
+			}
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(copyValue(v.Elem(), copies))
+		return c
+	}
+	return v
+}
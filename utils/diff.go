@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Difference is one point at which two values compared with DeepDiff disagree.
+//
+// Fields:
+//   - Path: Where the values differ, such as ".Items[2].Name" or `.Scores["bob"]`; the empty
+//     string denotes the compared values themselves
+//   - Expected: The value found in the first argument of DeepDiff (nil when missing)
+//   - Actual: The value found in the second argument of DeepDiff (nil when missing)
+//
+// Values of unexported struct fields cannot be extracted with reflection, so they are
+// reported as their fmt-formatted strings.
+type Difference struct {
+	Path     string
+	Expected any
+	Actual   any
+}
+
+func (d Difference) String() string {
+	path := d.Path
+	if path == "" {
+		path = "(root)"
+	}
+	return fmt.Sprintf("%s: expected %v, got %v", path, d.Expected, d.Actual)
+}
+
+// DeepDiff compares expected and actual the way reflect.DeepEqual does and returns every
+// point at which they differ, recursing into structs (unexported fields included),
+// arrays, slices, maps, pointers and interfaces. Slices and maps report missing and extra
+// elements individually; map differences are sorted by key for a stable output. Cyclic
+// values are handled by not revisiting a pair of pointers already being compared.
+//
+// DeepDiff returns nil exactly when reflect.DeepEqual(expected, actual) is true.
+//
+// Example usage:
+//
+//	diffs := utils.DeepDiff(Order{ID: 1, Items: []string{"a"}}, Order{ID: 2, Items: []string{"a", "b"}})
+//	// [.ID: expected 1, got 2  .Items[1]: expected <nil>, got b]
+func DeepDiff(expected, actual any) []Difference {
+	d := differ{visited: map[visit]bool{}}
+	d.diff("", reflect.ValueOf(expected), reflect.ValueOf(actual))
+	return d.diffs
+}
+
+// visit identifies a pair of references being compared, to detect cycles.
+type visit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+// differ accumulates the differences found by DeepDiff.
+type differ struct {
+	diffs   []Difference
+	visited map[visit]bool
+}
+
+func (d *differ) report(path string, a, b reflect.Value) {
+	d.diffs = append(d.diffs, Difference{Path: path, Expected: reportable(a), Actual: reportable(b)})
+}
+
+func (d *differ) diff(path string, a, b reflect.Value) {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			d.report(path, a, b)
+		}
+		return
+	}
+	if a.Type() != b.Type() {
+		d.report(path, a, b)
+		return
+	}
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.report(path, a, b)
+			}
+			return
+		}
+		if a.Pointer() == b.Pointer() && (a.Kind() != reflect.Slice || a.Len() == b.Len()) {
+			return
+		}
+		v := visit{a.Pointer(), b.Pointer(), a.Type()}
+		if d.visited[v] {
+			return
+		}
+		d.visited[v] = true
+	}
+	switch a.Kind() {
+	case reflect.Pointer:
+		d.diff(path, a.Elem(), b.Elem())
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.report(path, a, b)
+			}
+			return
+		}
+		d.diff(path, a.Elem(), b.Elem())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			d.diff(path+"."+a.Type().Field(i).Name, a.Field(i), b.Field(i))
+		}
+	case reflect.Array, reflect.Slice:
+		d.diffSequences(path, a, b)
+	case reflect.Map:
+		d.diffMaps(path, a, b)
+	default:
+		if !leafEqual(a, b) {
+			d.report(path, a, b)
+		}
+	}
+}
+
+// diffSequences compares arrays or slices element by element, reporting the elements
+// only one of them holds against an invalid value.
+func (d *differ) diffSequences(path string, a, b reflect.Value) {
+	for i := 0; i < max(a.Len(), b.Len()); i++ {
+		elemPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= a.Len():
+			d.report(elemPath, reflect.Value{}, b.Index(i))
+		case i >= b.Len():
+			d.report(elemPath, a.Index(i), reflect.Value{})
+		default:
+			d.diff(elemPath, a.Index(i), b.Index(i))
+		}
+	}
+}
+
+// diffMaps compares the entries of two maps in key order, reporting the keys only one of
+// them holds against an invalid value.
+func (d *differ) diffMaps(path string, a, b reflect.Value) {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+	for _, key := range keys {
+		keyPath := fmt.Sprintf("%s[%v]", path, key)
+		if key.Kind() == reflect.String {
+			keyPath = fmt.Sprintf("%s[%q]", path, key.String())
+		}
+		d.diff(keyPath, a.MapIndex(key), b.MapIndex(key))
+	}
+}
+
+// leafEqual compares two values of the same scalar kind with reflect.DeepEqual semantics.
+// It reads values through the kind-specific accessors, which also works for unexported
+// struct fields.
+func leafEqual(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Func:
+		return a.IsNil() && b.IsNil()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	}
+	return false
+}
+
+// reportable returns the value held by v for a Difference: nil when v is invalid, its
+// fmt-formatted string when it cannot be extracted (unexported fields).
+func reportable(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if !v.CanInterface() {
+		return fmt.Sprint(v)
+	}
+	return v.Interface()
+}
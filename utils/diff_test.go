@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type diffItem struct {
+	Name  string
+	Tags  []string
+	Score map[string]int
+	next  *diffItem
+	note  string
+}
+
+func TestDeepDiff(t *testing.T) {
+	a := diffItem{Name: "a", Tags: []string{"x", "y"}, Score: map[string]int{"bob": 1, "amy": 2}, note: "old"}
+	b := diffItem{Name: "b", Tags: []string{"x"}, Score: map[string]int{"bob": 3, "cid": 4}, note: "new"}
+	want := []Difference{
+		{Path: ".Name", Expected: "a", Actual: "b"},
+		{Path: ".Tags[1]", Expected: "y", Actual: nil},
+		{Path: `.Score["amy"]`, Expected: 2, Actual: nil},
+		{Path: `.Score["bob"]`, Expected: 1, Actual: 3},
+		{Path: `.Score["cid"]`, Expected: nil, Actual: 4},
+		{Path: ".note", Expected: "old", Actual: "new"},
+	}
+	if got := DeepDiff(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := DeepDiff(a, a); got != nil {
+		t.Errorf("expected no differences for equal values, got %v", got)
+	}
+}
+
+func TestDeepDiffMatchesDeepEqual(t *testing.T) {
+	var nilSlice []int
+	var nilPtr *int
+	one, other := 1, 1
+	cases := []struct{ a, b any }{
+		{nil, nil}, {nil, 1}, {1, int64(1)}, {nilSlice, []int{}}, {[]int{1}, []int{1}},
+		{&one, &other}, {nilPtr, &one}, {[2]int{1, 2}, [2]int{1, 3}}, {math.NaN(), math.NaN()},
+		{map[int]int{}, map[int]int(nil)}, {[]any{1, "a"}, []any{1, "b"}}, {func() {}, func() {}},
+	}
+	for _, c := range cases {
+		if equal := reflect.DeepEqual(c.a, c.b); equal != (DeepDiff(c.a, c.b) == nil) {
+			t.Errorf("DeepDiff(%v, %v) = %v disagrees with reflect.DeepEqual = %v", c.a, c.b, DeepDiff(c.a, c.b), equal)
+		}
+	}
+}
+
+func TestDeepDiffCycles(t *testing.T) {
+	a := &diffItem{Name: "a"}
+	a.next = a
+	b := &diffItem{Name: "a"}
+	b.next = b
+	if got := DeepDiff(a, b); got != nil {
+		t.Errorf("expected equal cyclic values to have no differences, got %v", got)
+	}
+	b.Name = "b"
+	if got := DeepDiff(a, b); len(got) != 1 || got[0].Path != ".Name" {
+		t.Errorf("expected a single difference on .Name, got %v", got)
+	}
+}
+
+func TestDifferenceString(t *testing.T) {
+	if s := (Difference{Path: ".Age", Expected: 1, Actual: 2}).String(); s != ".Age: expected 1, got 2" {
+		t.Errorf("unexpected String(): %s", s)
+	}
+	if s := (Difference{Expected: 1, Actual: "1"}).String(); s != "(root): expected 1, got 1" {
+		t.Errorf("unexpected String(): %s", s)
+	}
+}
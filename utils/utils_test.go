@@ -57,3 +57,74 @@ func TestFilter(t *testing.T) {
 		}
 	})
 }
+
+func TestLess(t *testing.T) {
+	cases := []struct {
+		a, b any
+		want bool
+	}{
+		{1, 2, true},
+		{2, 1, false},
+		{uint8(1), uint8(2), true},
+		{1.5, 1.25, false},
+		{"a", "b", true},
+		{1, "b", false},
+		{nil, 1, false},
+		{struct{}{}, struct{}{}, false},
+	}
+	for _, c := range cases {
+		if got := Less(c.a, c.b); got != c.want {
+			t.Errorf("Less(%v, %v) = %v, expected %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestIsOrdered(t *testing.T) {
+	if !IsOrdered(reflect.TypeOf("")) || !IsOrdered(reflect.TypeOf(uint(0))) {
+		t.Error("expected strings and unsigned integers to be ordered")
+	}
+	if IsOrdered(reflect.TypeOf(struct{}{})) || IsOrdered(nil) {
+		t.Error("expected structs and nil types not to be ordered")
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	type node struct {
+		Values []int
+		Next   *node
+	}
+	shared := &node{Values: []int{1}}
+	original := []any{[]int{1, 2}, map[string][]int{"a": {3}}, &node{Values: []int{4}, Next: shared}, nil}
+	copies := DeepCopyAll(original)
+	if !reflect.DeepEqual(copies, original) {
+		t.Fatalf("expected equal copies, got %v", copies)
+	}
+	copies[0].([]int)[0] = 9
+	copies[1].(map[string][]int)["a"][0] = 9
+	copies[2].(*node).Next.Values[0] = 9
+	if original[0].([]int)[0] != 1 || original[1].(map[string][]int)["a"][0] != 3 || shared.Values[0] != 1 {
+		t.Errorf("expected the copies to share nothing with the original, got %v", original)
+	}
+}
+
+func TestConvertsLosslessly(t *testing.T) {
+	type name string
+	cases := []struct {
+		v    any
+		t    reflect.Type
+		want bool
+	}{
+		{int64(100), reflect.TypeFor[int8](), true},
+		{int64(1000), reflect.TypeFor[int8](), false},
+		{1.5, reflect.TypeFor[int](), false},
+		{3, reflect.TypeFor[string](), false},
+		{"a", reflect.TypeFor[name](), true},
+		{uint(7), reflect.TypeFor[uint8](), true},
+		{[]int{1}, reflect.TypeFor[[]string](), false},
+	}
+	for _, c := range cases {
+		if got := ConvertsLosslessly(reflect.ValueOf(c.v), c.t); got != c.want {
+			t.Errorf("ConvertsLosslessly(%#v, %v) = %v, expected %v", c.v, c.t, got, c.want)
+		}
+	}
+}